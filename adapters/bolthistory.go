@@ -0,0 +1,268 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	bolt "go.etcd.io/bbolt"
+)
+
+var snapshotsBucket = []byte("snapshots")
+
+// BoltDepartureHistoryRepository stores departure snapshots in a bbolt file, with a bucket per station keyed by fetch time
+type BoltDepartureHistoryRepository struct {
+	path string
+	// mu guards db and unusable, which are swapped by Compact
+	mu sync.RWMutex
+	db *bolt.DB
+	// unusable is set if Compact couldn't reopen the database, every call fails with it until a later Compact reopens it
+	unusable error
+}
+
+func NewBoltDepartureHistoryRepository(path string) (*BoltDepartureHistoryRepository, error) {
+	db, err := openHistoryDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltDepartureHistoryRepository{
+		path: path,
+		db:   db,
+	}, nil
+}
+
+func openHistoryDB(path string) (*bolt.DB, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening history database: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(snapshotsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating history bucket: %w", err)
+	}
+
+	return db, nil
+}
+
+func (repository *BoltDepartureHistoryRepository) SaveSnapshot(ctx context.Context, snapshot domains.DepartureSnapshot) error {
+	value, err := json.Marshal(snapshot.Departures)
+	if err != nil {
+		return err
+	}
+
+	repository.mu.RLock()
+	defer repository.mu.RUnlock()
+	if repository.unusable != nil {
+		return repository.unusable
+	}
+
+	return repository.db.Update(func(tx *bolt.Tx) error {
+		station, err := tx.Bucket(snapshotsBucket).CreateBucketIfNotExists(stationKey(snapshot.StationID))
+		if err != nil {
+			return err
+		}
+		return station.Put(timeKey(snapshot.FetchedAt), value)
+	})
+}
+
+func (repository *BoltDepartureHistoryRepository) ListStationIDs(ctx context.Context) ([]int, error) {
+	repository.mu.RLock()
+	defer repository.mu.RUnlock()
+	if repository.unusable != nil {
+		return nil, repository.unusable
+	}
+
+	stationIDs := []int{}
+	err := repository.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(snapshotsBucket).ForEachBucket(func(name []byte) error {
+			stationIDs = append(stationIDs, int(binary.BigEndian.Uint64(name)))
+			return nil
+		})
+	})
+
+	return stationIDs, err
+}
+
+func (repository *BoltDepartureHistoryRepository) ListSnapshots(ctx context.Context, stationID int, from time.Time, to time.Time) ([]domains.DepartureSnapshot, error) {
+	repository.mu.RLock()
+	defer repository.mu.RUnlock()
+	if repository.unusable != nil {
+		return nil, repository.unusable
+	}
+
+	snapshots := []domains.DepartureSnapshot{}
+	err := repository.db.View(func(tx *bolt.Tx) error {
+		station := tx.Bucket(snapshotsBucket).Bucket(stationKey(stationID))
+		if station == nil {
+			return nil
+		}
+
+		end := timeKey(to)
+		cursor := station.Cursor()
+		for key, value := cursor.Seek(timeKey(from)); key != nil && bytes.Compare(key, end) < 0; key, value = cursor.Next() {
+			snapshot := domains.DepartureSnapshot{
+				StationID: stationID,
+				FetchedAt: keyTime(key),
+			}
+			if err := json.Unmarshal(value, &snapshot.Departures); err != nil {
+				return fmt.Errorf("decoding snapshot of station %d at %s: %w", stationID, snapshot.FetchedAt, err)
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		return nil
+	})
+
+	return snapshots, err
+}
+
+func (repository *BoltDepartureHistoryRepository) DeleteSnapshotsBefore(ctx context.Context, before time.Time) (int, error) {
+	return repository.deleteWhere(func(fetchedAt time.Time, previousKept time.Time) bool {
+		return fetchedAt.Before(before)
+	})
+}
+
+func (repository *BoltDepartureHistoryRepository) Downsample(ctx context.Context, before time.Time, interval time.Duration) (int, error) {
+	if interval <= 0 {
+		return 0, nil
+	}
+
+	return repository.deleteWhere(func(fetchedAt time.Time, previousKept time.Time) bool {
+		return fetchedAt.Before(before) && !previousKept.IsZero() && fetchedAt.Truncate(interval).Equal(previousKept.Truncate(interval))
+	})
+}
+
+// deleteWhere removes the snapshots of all stations for which remove returns true, it is called in order of fetch time
+// with the fetch time of the last kept snapshot of the station
+func (repository *BoltDepartureHistoryRepository) deleteWhere(remove func(fetchedAt time.Time, previousKept time.Time) bool) (int, error) {
+	repository.mu.RLock()
+	defer repository.mu.RUnlock()
+	if repository.unusable != nil {
+		return 0, repository.unusable
+	}
+
+	deleted := 0
+	err := repository.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(snapshotsBucket).ForEachBucket(func(name []byte) error {
+			station := tx.Bucket(snapshotsBucket).Bucket(name)
+
+			// Keys are collected first, deleting while iterating makes bbolt cursors skip keys
+			var keys [][]byte
+			var previousKept time.Time
+			err := station.ForEach(func(key, value []byte) error {
+				fetchedAt := keyTime(key)
+				if remove(fetchedAt, previousKept) {
+					keys = append(keys, append([]byte{}, key...))
+				} else {
+					previousKept = fetchedAt
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, key := range keys {
+				if err := station.Delete(key); err != nil {
+					return err
+				}
+			}
+			deleted += len(keys)
+			return nil
+		})
+	})
+
+	return deleted, err
+}
+
+// Compact rewrites the database into a new file, bbolt doesn't give the space of deleted snapshots back to the file system
+func (repository *BoltDepartureHistoryRepository) Compact(ctx context.Context) error {
+	repository.mu.Lock()
+	defer repository.mu.Unlock()
+
+	if repository.unusable != nil {
+		db, err := openHistoryDB(repository.path)
+		if err != nil {
+			return err
+		}
+		repository.db, repository.unusable = db, nil
+	}
+
+	compactPath := repository.path + ".compact"
+	os.Remove(compactPath)
+
+	compacted, err := bolt.Open(compactPath, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return fmt.Errorf("opening compacted history database: %w", err)
+	}
+	if err := bolt.Compact(compacted, repository.db, 64<<20); err != nil {
+		compacted.Close()
+		os.Remove(compactPath)
+		return fmt.Errorf("compacting history database: %w", err)
+	}
+	if err := compacted.Close(); err != nil {
+		os.Remove(compactPath)
+		return err
+	}
+
+	if err := repository.db.Close(); err != nil {
+		return err
+	}
+	renameErr := os.Rename(compactPath, repository.path)
+	if renameErr != nil {
+		// Keep working with the uncompacted file
+		os.Remove(compactPath)
+	}
+
+	db, err := openHistoryDB(repository.path)
+	if err != nil {
+		// The old handle is closed already, so calls fail instead of using it
+		repository.unusable = fmt.Errorf("history database unusable after compaction: %w", err)
+		return err
+	}
+	repository.db = db
+
+	if renameErr != nil {
+		return fmt.Errorf("replacing history database: %w", renameErr)
+	}
+	return nil
+}
+
+func (repository *BoltDepartureHistoryRepository) Close() error {
+	repository.mu.Lock()
+	defer repository.mu.Unlock()
+
+	if repository.unusable != nil {
+		// Compact closed the database already
+		return nil
+	}
+	return repository.db.Close()
+}
+
+func stationKey(stationID int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(stationID))
+	return key
+}
+
+// timeKey encodes the time big endian, so the byte order of keys is the chronological order
+func timeKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+	return key
+}
+
+func keyTime(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key)))
+}
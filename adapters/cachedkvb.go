@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CachedKVBAdapter caches the last good departures per station and serves them marked as stale while the upstream is failing
+type CachedKVBAdapter struct {
+	cache *departureCache[int]
+}
+
+func NewCachedKVBAdapter(next ports.KVBAdapter, options CacheOptions, logger *slog.Logger) *CachedKVBAdapter {
+	return &CachedKVBAdapter{
+		cache: newDepartureCache(next.GetDeparturesForStationID, func(stationID int) slog.Attr {
+			return slog.Int("stationID", stationID)
+		}, options, logger),
+	}
+}
+
+func (adapter *CachedKVBAdapter) GetDeparturesForStationID(ctx context.Context, stationID int) (domains.Departures, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "CachedKVBAdapter.GetDeparturesForStationID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stationID", stationID))
+
+	return adapter.cache.get(ctx, span, stationID)
+}
@@ -0,0 +1,38 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CachedDepartureProvider caches the departures of a provider like CachedKVBAdapter caches KVB, station searches
+// aren't cached
+type CachedDepartureProvider struct {
+	ports.DepartureProvider
+	cache *departureCache[string]
+}
+
+func NewCachedDepartureProvider(next ports.DepartureProvider, options CacheOptions, logger *slog.Logger) *CachedDepartureProvider {
+	return &CachedDepartureProvider{
+		DepartureProvider: next,
+		cache: newDepartureCache(next.GetDepartures, func(stationID string) slog.Attr {
+			return slog.String("station", domains.StationRef{Provider: next.Name(), ID: stationID}.String())
+		}, options, logger),
+	}
+}
+
+func (provider *CachedDepartureProvider) GetDepartures(ctx context.Context, stationID string) (domains.Departures, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "CachedDepartureProvider.GetDepartures")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("station", domains.StationRef{Provider: provider.Name(), ID: stationID}.String()))
+
+	return provider.cache.get(ctx, span, stationID)
+}
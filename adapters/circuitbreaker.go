@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/metrics"
+)
+
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerHalfOpen
+	BreakerOpen
+)
+
+func (state BreakerState) String() string {
+	switch state {
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker opens after failureThreshold consecutive failures and lets a single probe through after the cooldown
+type circuitBreaker struct {
+	name             string
+	failureThreshold int
+	cooldown         time.Duration
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(name string, failureThreshold int, cooldown time.Duration) *circuitBreaker {
+	breaker := &circuitBreaker{
+		name:             name,
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+	}
+	metrics.CircuitBreakerState.WithLabelValues(name).Set(float64(BreakerClosed))
+
+	return breaker
+}
+
+// allow reports whether a request may be sent, moving from open to half-open once the cooldown passed
+func (breaker *circuitBreaker) allow() bool {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	switch breaker.state {
+	case BreakerOpen:
+		if time.Since(breaker.openedAt) < breaker.cooldown {
+			return false
+		}
+		breaker.setState(BreakerHalfOpen)
+		breaker.probing = true
+		return true
+	case BreakerHalfOpen:
+		if breaker.probing {
+			return false
+		}
+		breaker.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (breaker *circuitBreaker) success() {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	breaker.failures = 0
+	breaker.probing = false
+	breaker.setState(BreakerClosed)
+}
+
+func (breaker *circuitBreaker) failure() {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	breaker.failures++
+	breaker.probing = false
+	if breaker.state == BreakerHalfOpen || breaker.failures >= breaker.failureThreshold {
+		breaker.openedAt = time.Now()
+		breaker.setState(BreakerOpen)
+	}
+}
+
+// release gives up a half-open probe without judging the upstream, for requests the caller cancelled or the rate limit
+// rejected before they reached KVB
+func (breaker *circuitBreaker) release() {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	breaker.probing = false
+}
+
+func (breaker *circuitBreaker) State() BreakerState {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	return breaker.state
+}
+
+// setState must be called with mu held
+func (breaker *circuitBreaker) setState(state BreakerState) {
+	breaker.state = state
+	metrics.CircuitBreakerState.WithLabelValues(breaker.name).Set(float64(state))
+}
@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/logging"
+	"github.com/janritter/kvb-api/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	CacheHit        = "hit"
+	CacheMiss       = "miss"
+	CacheRevalidate = "stale-while-revalidate"
+	CacheStale      = "stale-if-error"
+)
+
+type CacheOptions struct {
+	// TTL is the time departures are served without asking the upstream
+	TTL time.Duration
+	// StaleWhileRevalidate is the time after the TTL in which stale departures are served while refreshing in the background, zero disables it
+	StaleWhileRevalidate time.Duration
+	// MaxStaleness is the maximum age of departures served when the upstream fails, zero disables stale-if-error
+	MaxStaleness time.Duration
+}
+
+type cacheEntry struct {
+	departures domains.Departures
+	refreshing bool
+}
+
+// departureCache caches the last good departures per station and serves them marked as stale while the upstream is
+// failing. Stations are keyed by K, the KVB station ID or the station ID of a provider.
+type departureCache[K comparable] struct {
+	fetch   func(ctx context.Context, station K) (domains.Departures, error)
+	attr    func(station K) slog.Attr
+	options CacheOptions
+	logger  *slog.Logger
+
+	mu      sync.Mutex
+	entries map[K]*cacheEntry
+}
+
+func newDepartureCache[K comparable](fetch func(ctx context.Context, station K) (domains.Departures, error), attr func(station K) slog.Attr, options CacheOptions, logger *slog.Logger) *departureCache[K] {
+	return &departureCache[K]{
+		fetch:   fetch,
+		attr:    attr,
+		options: options,
+		logger:  logger,
+		entries: map[K]*cacheEntry{},
+	}
+}
+
+func (cache *departureCache[K]) get(ctx context.Context, span trace.Span, station K) (domains.Departures, error) {
+	now := time.Now()
+	cached, found := cache.lookup(station)
+	age := now.Sub(cached.FetchedAt)
+
+	switch {
+	case found && age < cache.options.TTL:
+		cache.record(ctx, span, CacheHit)
+		return cached, nil
+	case found && age < cache.options.TTL+cache.options.StaleWhileRevalidate:
+		cache.revalidate(ctx, station)
+		cache.record(ctx, span, CacheRevalidate)
+		return cached.Aged(now), nil
+	}
+
+	departures, err := cache.fetch(ctx, station)
+	if err != nil {
+		if found && age < cache.options.MaxStaleness {
+			cache.logger.WarnContext(ctx, "Serving stale departures after upstream error",
+				cache.attr(station),
+				slog.Duration("age", age),
+				slog.Any("error", err),
+			)
+			cache.record(ctx, span, CacheStale)
+			return cached.Aged(now), nil
+		}
+
+		cache.record(ctx, span, CacheMiss)
+		return domains.Departures{}, err
+	}
+
+	cache.store(station, departures)
+	cache.record(ctx, span, CacheMiss)
+
+	return departures, nil
+}
+
+func (cache *departureCache[K]) lookup(station K) (domains.Departures, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	entry, found := cache.entries[station]
+	if !found {
+		return domains.Departures{}, false
+	}
+
+	return entry.departures, true
+}
+
+func (cache *departureCache[K]) store(station K, departures domains.Departures) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.entries[station] = &cacheEntry{departures: departures}
+}
+
+// revalidate refreshes the departures of a station in the background, at most one refresh per station runs at a time
+func (cache *departureCache[K]) revalidate(ctx context.Context, station K) {
+	cache.mu.Lock()
+	entry := cache.entries[station]
+	if entry.refreshing {
+		cache.mu.Unlock()
+		return
+	}
+	entry.refreshing = true
+	cache.mu.Unlock()
+
+	go func() {
+		refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+		defer cancel()
+
+		departures, err := cache.fetch(refreshCtx, station)
+		if err != nil {
+			cache.logger.WarnContext(refreshCtx, "Error revalidating departures", cache.attr(station), slog.Any("error", err))
+
+			cache.mu.Lock()
+			entry.refreshing = false
+			cache.mu.Unlock()
+			return
+		}
+
+		cache.store(station, departures)
+	}()
+}
+
+func (cache *departureCache[K]) record(ctx context.Context, span trace.Span, outcome string) {
+	span.SetAttributes(attribute.String("cache", outcome))
+	metrics.CacheRequests.WithLabelValues(outcome).Inc()
+	logging.SetCacheOutcome(ctx, outcome)
+}
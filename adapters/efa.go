@@ -0,0 +1,200 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EFAFormat is the output format requested from EFA
+type EFAFormat string
+
+const (
+	EFAFormatJSON EFAFormat = "json"
+	EFAFormatXML  EFAFormat = "xml"
+)
+
+// efaDepartureLimit is the number of departures requested, about the length of the KVB boards
+const efaDepartureLimit = 30
+
+// efaRequestTimeout bounds EFA requests, which aren't retried
+const efaRequestTimeout = 10 * time.Second
+
+// ParseEFAFormat returns the format of the name
+func ParseEFAFormat(name string) (EFAFormat, error) {
+	switch format := EFAFormat(strings.ToLower(name)); format {
+	case EFAFormatJSON, EFAFormatXML:
+		return format, nil
+	}
+	return "", fmt.Errorf("unknown EFA format %q, supported are json and xml", name)
+}
+
+// EFAAdapter requests departures and stops from an EFA (Elektronische Fahrplanauskunft) server via the departure
+// monitor and stop finder requests. Unlike the KVB pages EFA knows platforms, planned times and real-time delays.
+type EFAAdapter struct {
+	baseURL string
+	format  EFAFormat
+	client  *http.Client
+	logger  *slog.Logger
+}
+
+// NewEFAAdapter creates an adapter requesting baseURL, e.g. https://efa.example.org/standard, through transport,
+// http.DefaultTransport is used if it is nil
+func NewEFAAdapter(baseURL string, format EFAFormat, transport http.RoundTripper, logger *slog.Logger) *EFAAdapter {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &EFAAdapter{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		format:  format,
+		client:  &http.Client{Transport: otelhttp.NewTransport(transport), Timeout: efaRequestTimeout},
+		logger:  logger,
+	}
+}
+
+// GetDepartures returns the departures of the EFA stop, the station is named like EFA names the stop
+func (adapter *EFAAdapter) GetDepartures(ctx context.Context, stopID string) (domains.Departures, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetEFADepartures")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("stopID", stopID))
+
+	query := url.Values{
+		"language":     {"de"},
+		"type_dm":      {"stop"},
+		"name_dm":      {stopID},
+		"mode":         {"direct"},
+		"useRealtime":  {"1"},
+		"limit":        {strconv.Itoa(efaDepartureLimit)},
+		"outputFormat": {strings.ToUpper(string(adapter.format))},
+	}
+
+	var efaDepartures []efaDeparture
+	var err error
+	if adapter.format == EFAFormatXML {
+		var response efaXMLResponse
+		err = adapter.request(ctx, "XML_DM_REQUEST", query, &response)
+		efaDepartures = response.departures()
+	} else {
+		var response efaJSONDepartureMonitor
+		err = adapter.request(ctx, "XML_DM_REQUEST", query, &response)
+		efaDepartures = response.departures()
+	}
+	if err != nil {
+		adapter.logger.ErrorContext(ctx, "Error requesting EFA departures", slog.String("stopID", stopID), slog.Any("error", err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domains.Departures{}, err
+	}
+
+	now := time.Now()
+	departures := domains.Departures{
+		Departures: []domains.Departure{},
+		FetchedAt:  now,
+	}
+	for _, efaDeparture := range efaDepartures {
+		if departures.Station == "" {
+			departures.Station = efaDeparture.stopName
+		}
+		if departure, ok := efaDeparture.toDeparture(now); ok {
+			departures.Departures = append(departures.Departures, departure)
+		}
+	}
+
+	return departures, nil
+}
+
+// EFAStop is a stop found by the EFA stop finder
+type EFAStop struct {
+	ID   string
+	Name string
+}
+
+// SearchStops returns up to limit stops matching the query, best match first
+func (adapter *EFAAdapter) SearchStops(ctx context.Context, query string, limit int) ([]EFAStop, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "SearchEFAStops")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query", query))
+
+	values := url.Values{
+		"language":        {"de"},
+		"type_sf":         {"any"},
+		"name_sf":         {query},
+		"anyObjFilter_sf": {"2"}, // stops only
+		"outputFormat":    {strings.ToUpper(string(adapter.format))},
+	}
+
+	var matches []efaStopMatch
+	var err error
+	if adapter.format == EFAFormatXML {
+		var response efaXMLResponse
+		err = adapter.request(ctx, "XML_STOPFINDER_REQUEST", values, &response)
+		matches = response.stops()
+	} else {
+		var response efaJSONStopFinder
+		err = adapter.request(ctx, "XML_STOPFINDER_REQUEST", values, &response)
+		matches = response.stops()
+	}
+	if err != nil {
+		adapter.logger.ErrorContext(ctx, "Error searching EFA stops", slog.String("query", query), slog.Any("error", err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].quality > matches[j].quality
+	})
+	stops := []EFAStop{}
+	for _, match := range matches {
+		if limit > 0 && len(stops) >= limit {
+			break
+		}
+		stops = append(stops, match.EFAStop)
+	}
+	return stops, nil
+}
+
+func (adapter *EFAAdapter) request(ctx context.Context, endpoint string, query url.Values, response interface{}) error {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, adapter.baseURL+"/"+endpoint+"?"+query.Encode(), nil)
+	res, err := adapter.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return &domains.UpstreamStatusError{StatusCode: res.StatusCode}
+	}
+
+	if adapter.format == EFAFormatXML {
+		decoder := xml.NewDecoder(res.Body)
+		decoder.CharsetReader = charsetReader
+		err = decoder.Decode(response)
+	} else {
+		err = json.NewDecoder(res.Body).Decode(response)
+	}
+	if err != nil {
+		return fmt.Errorf("error decoding EFA %s: %w", endpoint, err)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// efaNow is half a minute after the first departure of the fixtures
+var efaNow = time.Date(2024, 3, 1, 8, 15, 30, 0, domains.Location)
+
+func intPointer(value int) *int {
+	return &value
+}
+
+// expectedEFADepartures are the departures of testdata/efa-dm.* as of efaNow. The departure of line 3 is in the past
+// and the trip of line 7 is cancelled, EFA sends a delay of -9999 for it.
+var expectedEFADepartures = []domains.Departure{
+	// Sofort, on time
+	{Line: "9", Destination: "Sülz Hermeskeiler Platz", ArrivalInMinutes: 0, Platform: "1", DelayMinutes: intPointer(0)},
+	// Delay from the real-time departure
+	{Line: "16", Destination: "Bonn Hbf", ArrivalInMinutes: 4, Platform: "2", DelayMinutes: intPointer(3)},
+	// Platform name without platform and no real-time data
+	{Line: "136", Destination: "Hohenlind", ArrivalInMinutes: 12, Platform: "Bussteig A"},
+	// Countdown computed from the real-time departure
+	{Line: "1", Destination: "Weiden West", ArrivalInMinutes: 27, Platform: "1", DelayMinutes: intPointer(2)},
+}
+
+var expectedEFAPlannedTimes = []time.Time{
+	time.Date(2024, 3, 1, 8, 15, 0, 0, domains.Location),
+	time.Date(2024, 3, 1, 8, 16, 0, 0, domains.Location),
+	time.Date(2024, 3, 1, 8, 27, 0, 0, domains.Location),
+	time.Date(2024, 3, 1, 8, 40, 0, 0, domains.Location),
+}
+
+func readEFAFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func convertEFADepartures(efaDepartures []efaDeparture) []domains.Departure {
+	departures := []domains.Departure{}
+	for _, efaDeparture := range efaDepartures {
+		if departure, ok := efaDeparture.toDeparture(efaNow); ok {
+			departures = append(departures, departure)
+		}
+	}
+	return departures
+}
+
+func assertEFADepartures(t *testing.T, departures []domains.Departure, stopName string) {
+	t.Helper()
+
+	if stopName != "Köln Neumarkt" {
+		t.Errorf("expected stop name Köln Neumarkt, got %q", stopName)
+	}
+	if len(departures) != len(expectedEFADepartures) {
+		t.Fatalf("expected %d departures, got %d: %+v", len(expectedEFADepartures), len(departures), departures)
+	}
+
+	for i, departure := range departures {
+		expected := expectedEFADepartures[i]
+		if departure.Line != expected.Line || departure.Destination != expected.Destination || departure.ArrivalInMinutes != expected.ArrivalInMinutes || departure.Platform != expected.Platform {
+			t.Errorf("departure %d: expected %+v, got %+v", i, expected, departure)
+		}
+		if (departure.DelayMinutes == nil) != (expected.DelayMinutes == nil) || (departure.DelayMinutes != nil && *departure.DelayMinutes != *expected.DelayMinutes) {
+			t.Errorf("departure %d: expected delay %v, got %v", i, expected.DelayMinutes, departure.DelayMinutes)
+		}
+		if departure.PlannedTime == nil || !departure.PlannedTime.Equal(expectedEFAPlannedTimes[i]) {
+			t.Errorf("departure %d: expected planned time %s, got %v", i, expectedEFAPlannedTimes[i], departure.PlannedTime)
+		}
+	}
+}
+
+func TestEFAJSONDepartures(t *testing.T) {
+	var response efaJSONDepartureMonitor
+	if err := json.Unmarshal(readEFAFixture(t, "efa-dm.json"), &response); err != nil {
+		t.Fatal(err)
+	}
+
+	efaDepartures := response.departures()
+	assertEFADepartures(t, convertEFADepartures(efaDepartures), efaDepartures[0].stopName)
+}
+
+func TestEFAXMLDepartures(t *testing.T) {
+	var response efaXMLResponse
+	decoder := xml.NewDecoder(bytes.NewReader(readEFAFixture(t, "efa-dm.xml")))
+	decoder.CharsetReader = charsetReader
+	if err := decoder.Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+
+	efaDepartures := response.departures()
+	assertEFADepartures(t, convertEFADepartures(efaDepartures), efaDepartures[0].stopName)
+}
+
+func TestEFAJSONSingleElementList(t *testing.T) {
+	var response efaJSONStopFinder
+	if err := json.Unmarshal(readEFAFixture(t, "efa-stopfinder-single.json"), &response); err != nil {
+		t.Fatal(err)
+	}
+
+	stops := response.stops()
+	if len(stops) != 1 || stops[0].ID != "22000001" || stops[0].Name != "Köln, Neumarkt" {
+		t.Errorf("expected the wrapped stop with its stateless ID, got %+v", stops)
+	}
+}
+
+// newEFATestServer answers the EFA requests with the fixtures of the format
+func newEFATestServer(t *testing.T, format EFAFormat) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("outputFormat"); got != strings.ToUpper(string(format)) {
+			t.Errorf("expected output format %s, got %s", strings.ToUpper(string(format)), got)
+		}
+
+		var fixture string
+		switch r.URL.Path {
+		case "/XML_DM_REQUEST":
+			fixture = "efa-dm." + string(format)
+		case "/XML_STOPFINDER_REQUEST":
+			fixture = "efa-stopfinder." + string(format)
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(readEFAFixture(t, fixture))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEFAAdapter(t *testing.T) {
+	for _, format := range []EFAFormat{EFAFormatJSON, EFAFormatXML} {
+		t.Run(string(format), func(t *testing.T) {
+			server := newEFATestServer(t, format)
+			adapter := NewEFAAdapter(server.URL, format, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			departures, err := adapter.GetDepartures(context.Background(), "22000001")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if departures.Station != "Köln Neumarkt" {
+				t.Errorf("expected station Köln Neumarkt, got %q", departures.Station)
+			}
+			// The departures without countdown are in the past by now, the others keep their countdown
+			if len(departures.Departures) != 3 {
+				t.Fatalf("expected 3 departures, got %+v", departures.Departures)
+			}
+			for i, departure := range departures.Departures {
+				if departure.Line != expectedEFADepartures[i].Line || departure.ArrivalInMinutes != expectedEFADepartures[i].ArrivalInMinutes {
+					t.Errorf("departure %d: expected %+v, got %+v", i, expectedEFADepartures[i], departure)
+				}
+			}
+
+			stops, err := adapter.SearchStops(context.Background(), "Neumarkt", 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Ordered by match quality, streets are left out
+			expected := []EFAStop{{ID: "22000002", Name: "Köln, Neumarkt-Galerie"}, {ID: "22000001", Name: "Köln, Neumarkt"}}
+			if len(stops) != len(expected) || stops[0] != expected[0] || stops[1] != expected[1] {
+				t.Errorf("expected stops %+v, got %+v", expected, stops)
+			}
+		})
+	}
+}
+
+func TestEFAAdapterUpstreamError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	adapter := NewEFAAdapter(server.URL, EFAFormatJSON, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	_, err := adapter.GetDepartures(context.Background(), "22000001")
+	var statusErr *domains.UpstreamStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected an upstream status error 502, got %v", err)
+	}
+}
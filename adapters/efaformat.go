@@ -0,0 +1,311 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// efaDeparture is a departure of the EFA departure monitor in either output format
+type efaDeparture struct {
+	stopName    string
+	line        string
+	destination string
+	platform    string
+	// countdown is -1 if EFA didn't send one
+	countdown int
+	planned   time.Time
+	// realtime is zero without real-time data
+	realtime  time.Time
+	delay     *int
+	cancelled bool
+}
+
+// toDeparture converts the departure, ok is false for departures in the past and cancelled trips
+func (efa efaDeparture) toDeparture(now time.Time) (domains.Departure, bool) {
+	if efa.cancelled {
+		return domains.Departure{}, false
+	}
+
+	departure := domains.Departure{
+		Line:             efa.line,
+		Destination:      efa.destination,
+		ArrivalInMinutes: efa.countdown,
+		Platform:         efa.platform,
+		DelayMinutes:     efa.delay,
+	}
+	if !efa.planned.IsZero() {
+		planned := efa.planned
+		departure.PlannedTime = &planned
+	}
+	if !efa.realtime.IsZero() && !efa.planned.IsZero() {
+		delay := int(efa.realtime.Sub(efa.planned) / time.Minute)
+		departure.DelayMinutes = &delay
+	}
+
+	if departure.ArrivalInMinutes < 0 {
+		expected := efa.realtime
+		if expected.IsZero() {
+			expected = efa.planned
+		}
+		if expected.IsZero() {
+			return domains.Departure{}, false
+		}
+		departure.ArrivalInMinutes = int(expected.Sub(now.Truncate(time.Minute)) / time.Minute)
+	}
+
+	return departure, departure.ArrivalInMinutes >= 0
+}
+
+type efaStopMatch struct {
+	EFAStop
+	quality int
+}
+
+// efaJSONList is a list in EFA's JSON, which sends single elements as object wrapping the element, e.g.
+// {"departure": {...}} instead of [{...}], and no elements as null
+type efaJSONList[T any] []T
+
+func (list *efaJSONList[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) == 0 || bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)):
+		*list = nil
+		return nil
+	case data[0] == '[':
+		var elements []T
+		err := json.Unmarshal(data, &elements)
+		*list = elements
+		return err
+	case data[0] == '{':
+		var wrapped map[string]T
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			return err
+		}
+		*list = nil
+		for _, element := range wrapped {
+			*list = append(*list, element)
+		}
+		return nil
+	}
+	return fmt.Errorf("unexpected EFA list %.20s", data)
+}
+
+type efaJSONDepartureMonitor struct {
+	DepartureList efaJSONList[efaJSONDeparture] `json:"departureList"`
+}
+
+type efaJSONDeparture struct {
+	StopName     string           `json:"stopName"`
+	Platform     string           `json:"platform"`
+	PlatformName string           `json:"platformName"`
+	Countdown    string           `json:"countdown"`
+	DateTime     *efaJSONDateTime `json:"dateTime"`
+	RealDateTime *efaJSONDateTime `json:"realDateTime"`
+	ServingLine  struct {
+		Number    string `json:"number"`
+		Symbol    string `json:"symbol"`
+		Direction string `json:"direction"`
+		Delay     string `json:"delay"`
+	} `json:"servingLine"`
+}
+
+type efaJSONDateTime struct {
+	Year   string `json:"year"`
+	Month  string `json:"month"`
+	Day    string `json:"day"`
+	Hour   string `json:"hour"`
+	Minute string `json:"minute"`
+}
+
+func (monitor efaJSONDepartureMonitor) departures() []efaDeparture {
+	departures := make([]efaDeparture, 0, len(monitor.DepartureList))
+	for _, departure := range monitor.DepartureList {
+		efa := efaDeparture{
+			stopName:    departure.StopName,
+			line:        firstNonEmpty(departure.ServingLine.Number, departure.ServingLine.Symbol),
+			destination: departure.ServingLine.Direction,
+			platform:    firstNonEmpty(departure.Platform, departure.PlatformName),
+			countdown:   parseEFAInt(departure.Countdown, -1),
+		}
+		if departure.DateTime != nil {
+			efa.planned = efaTime(departure.DateTime.Year, departure.DateTime.Month, departure.DateTime.Day, departure.DateTime.Hour, departure.DateTime.Minute)
+		}
+		if departure.RealDateTime != nil {
+			efa.realtime = efaTime(departure.RealDateTime.Year, departure.RealDateTime.Month, departure.RealDateTime.Day, departure.RealDateTime.Hour, departure.RealDateTime.Minute)
+		}
+		efa.delay, efa.cancelled = efaDelay(departure.ServingLine.Delay)
+		departures = append(departures, efa)
+	}
+	return departures
+}
+
+type efaJSONStopFinder struct {
+	StopFinder struct {
+		Points efaJSONList[efaJSONPoint] `json:"points"`
+	} `json:"stopFinder"`
+}
+
+type efaJSONPoint struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	AnyType   string `json:"anyType"`
+	Stateless string `json:"stateless"`
+	Quality   string `json:"quality"`
+	Ref       struct {
+		ID string `json:"id"`
+	} `json:"ref"`
+}
+
+func (finder efaJSONStopFinder) stops() []efaStopMatch {
+	stops := []efaStopMatch{}
+	for _, point := range finder.StopFinder.Points {
+		if point.Type != "stop" && point.AnyType != "stop" {
+			continue
+		}
+		id := firstNonEmpty(point.Ref.ID, point.Stateless)
+		if id == "" {
+			continue
+		}
+		stops = append(stops, efaStopMatch{EFAStop: EFAStop{ID: id, Name: point.Name}, quality: parseEFAInt(point.Quality, 0)})
+	}
+	return stops
+}
+
+// efaXMLResponse is the itdRequest of both the departure monitor and the stop finder
+type efaXMLResponse struct {
+	Departures []efaXMLDeparture `xml:"itdDepartureMonitorRequest>itdDepartureList>itdDeparture"`
+	Points     []efaXMLPoint     `xml:"itdStopFinderRequest>itdOdv>itdOdvName>odvNameElem"`
+}
+
+type efaXMLDeparture struct {
+	StopName     string          `xml:"stopName,attr"`
+	Platform     string          `xml:"platform,attr"`
+	PlatformName string          `xml:"platformName,attr"`
+	Countdown    string          `xml:"countdown,attr"`
+	DateTime     *efaXMLDateTime `xml:"itdDateTime"`
+	RTDateTime   *efaXMLDateTime `xml:"itdRTDateTime"`
+	ServingLine  struct {
+		Number    string `xml:"number,attr"`
+		Symbol    string `xml:"symbol,attr"`
+		Direction string `xml:"direction,attr"`
+		NoTrain   struct {
+			Delay string `xml:"delay,attr"`
+		} `xml:"itdNoTrain"`
+	} `xml:"itdServingLine"`
+}
+
+type efaXMLDateTime struct {
+	Date struct {
+		Year  string `xml:"year,attr"`
+		Month string `xml:"month,attr"`
+		Day   string `xml:"day,attr"`
+	} `xml:"itdDate"`
+	Time struct {
+		Hour   string `xml:"hour,attr"`
+		Minute string `xml:"minute,attr"`
+	} `xml:"itdTime"`
+}
+
+type efaXMLPoint struct {
+	Name         string `xml:",chardata"`
+	AnyType      string `xml:"anyType,attr"`
+	StopID       string `xml:"stopID,attr"`
+	MatchQuality string `xml:"matchQuality,attr"`
+}
+
+func (response efaXMLResponse) departures() []efaDeparture {
+	departures := make([]efaDeparture, 0, len(response.Departures))
+	for _, departure := range response.Departures {
+		efa := efaDeparture{
+			stopName:    departure.StopName,
+			line:        firstNonEmpty(departure.ServingLine.Number, departure.ServingLine.Symbol),
+			destination: departure.ServingLine.Direction,
+			platform:    firstNonEmpty(departure.Platform, departure.PlatformName),
+			countdown:   parseEFAInt(departure.Countdown, -1),
+		}
+		efa.delay, efa.cancelled = efaDelay(departure.ServingLine.NoTrain.Delay)
+		if dateTime := departure.DateTime; dateTime != nil {
+			efa.planned = efaTime(dateTime.Date.Year, dateTime.Date.Month, dateTime.Date.Day, dateTime.Time.Hour, dateTime.Time.Minute)
+		}
+		if dateTime := departure.RTDateTime; dateTime != nil {
+			efa.realtime = efaTime(dateTime.Date.Year, dateTime.Date.Month, dateTime.Date.Day, dateTime.Time.Hour, dateTime.Time.Minute)
+		}
+		departures = append(departures, efa)
+	}
+	return departures
+}
+
+func (response efaXMLResponse) stops() []efaStopMatch {
+	stops := []efaStopMatch{}
+	for _, point := range response.Points {
+		if point.StopID == "" || (point.AnyType != "" && point.AnyType != "stop") {
+			continue
+		}
+		stops = append(stops, efaStopMatch{EFAStop: EFAStop{ID: point.StopID, Name: strings.TrimSpace(point.Name)}, quality: parseEFAInt(point.MatchQuality, 0)})
+	}
+	return stops
+}
+
+// efaTime returns the local time of the EFA date and time fields, zero if they are incomplete
+func efaTime(year, month, day, hour, minute string) time.Time {
+	values := make([]int, 0, 5)
+	for _, field := range []string{year, month, day, hour, minute} {
+		value, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return time.Time{}
+		}
+		values = append(values, value)
+	}
+	return time.Date(values[0], time.Month(values[1]), values[2], values[3], values[4], 0, 0, domains.Location)
+}
+
+// efaCancelledDelay is the delay EFA sends for cancelled trips
+const efaCancelledDelay = -9999
+
+// efaDelay returns the delay in minutes, nil without real-time data, and whether the trip is cancelled
+func efaDelay(value string) (*int, bool) {
+	delay, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return nil, false
+	}
+	if delay == efaCancelledDelay {
+		return nil, true
+	}
+	return &delay, false
+}
+
+func parseEFAInt(value string, fallback int) int {
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// charsetReader decodes the ISO-8859-1 some EFA servers answer in, UTF-8 is handled by the XML decoder itself
+func charsetReader(label string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(label) {
+	case "iso-8859-1", "latin1", "latin-1":
+		return charmap.ISO8859_1.NewDecoder().Reader(input), nil
+	case "windows-1252", "cp1252":
+		return charmap.Windows1252.NewDecoder().Reader(input), nil
+	}
+	return nil, fmt.Errorf("unsupported charset %q", label)
+}
@@ -0,0 +1,44 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// EFAProvider serves the stops of an EFA server as departure provider, station IDs are EFA stop IDs
+type EFAProvider struct {
+	name    string
+	adapter *EFAAdapter
+}
+
+func NewEFAProvider(name string, adapter *EFAAdapter) *EFAProvider {
+	return &EFAProvider{
+		name:    name,
+		adapter: adapter,
+	}
+}
+
+func (provider *EFAProvider) Name() string {
+	return provider.name
+}
+
+func (provider *EFAProvider) GetDepartures(ctx context.Context, stationID string) (domains.Departures, error) {
+	return provider.adapter.GetDepartures(ctx, stationID)
+}
+
+func (provider *EFAProvider) SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error) {
+	stops, err := provider.adapter.SearchStops(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	stations := make([]domains.Station, 0, len(stops))
+	for _, stop := range stops {
+		stations = append(stations, domains.Station{
+			Ref:  domains.StationRef{Provider: provider.name, ID: stop.ID}.String(),
+			Name: stop.Name,
+		})
+	}
+	return stations, nil
+}
@@ -0,0 +1,400 @@
+package adapters
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// GTFSStaticOptions configures the import of a static GTFS feed
+type GTFSStaticOptions struct {
+	// AgencyID restricts the import to the routes of one agency, empty imports all routes of the feed
+	AgencyID string
+	// Overrides maps KVB station IDs to GTFS stop_ids for stations the name matching gets wrong
+	Overrides map[int]string
+}
+
+// GTFSStaticAdapter provides coordinates, routes and accessibility of the KVB stations from a static GTFS feed
+type GTFSStaticAdapter struct {
+	details          map[int]domains.StationDetails
+	routeIDs         map[string]string
+	unmatched        []string
+	invalidOverrides []string
+}
+
+// gtfsStop is a row of stops.txt
+type gtfsStop struct {
+	name         string
+	parent       string
+	latitude     float64
+	longitude    float64
+	hasLocation  bool
+	wheelchair   string
+	servedRoutes map[string]struct{}
+	childStopIDs []string
+}
+
+// optionalGTFSColumns may be missing in a feed, all other requested columns are required
+var optionalGTFSColumns = map[string]bool{
+	"agency_id":           true,
+	"route_short_name":    true,
+	"parent_station":      true,
+	"wheelchair_boarding": true,
+}
+
+// NewGTFSStaticAdapter imports the GTFS zip at path and matches its stops to the given KVB stations by name.
+// Stops are grouped by their parent station, a KVB station gets the coordinates and accessibility of the parent
+// station and the routes serving any of its platforms.
+func NewGTFSStaticAdapter(zipPath string, stations []domains.Station, options GTFSStaticOptions) (*GTFSStaticAdapter, error) {
+	archive, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening GTFS feed: %w", err)
+	}
+	defer archive.Close()
+
+	// Route short names by route_id, restricted to the configured agency
+	routes := map[string]string{}
+	routeIDs := map[string]string{}
+	err = forEachGTFSRow(archive, "routes.txt", []string{"route_id", "agency_id", "route_short_name"}, func(row []string) {
+		if options.AgencyID != "" && row[1] != options.AgencyID {
+			return
+		}
+
+		name := row[2]
+		if name == "" {
+			name = row[0]
+		}
+		routes[row[0]] = name
+		if _, found := routeIDs[name]; !found {
+			routeIDs[name] = row[0]
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tripRoutes := map[string]string{}
+	err = forEachGTFSRow(archive, "trips.txt", []string{"trip_id", "route_id"}, func(row []string) {
+		if name, found := routes[row[1]]; found {
+			tripRoutes[row[0]] = name
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stops := map[string]*gtfsStop{}
+	err = forEachGTFSRow(archive, "stops.txt", []string{"stop_id", "stop_name", "stop_lat", "stop_lon", "parent_station", "wheelchair_boarding"}, func(row []string) {
+		stop := &gtfsStop{
+			name:         row[1],
+			parent:       row[4],
+			wheelchair:   row[5],
+			servedRoutes: map[string]struct{}{},
+		}
+		latitude, latErr := strconv.ParseFloat(row[2], 64)
+		longitude, lonErr := strconv.ParseFloat(row[3], 64)
+		if latErr == nil && lonErr == nil {
+			stop.latitude, stop.longitude, stop.hasLocation = latitude, longitude, true
+		}
+		stops[row[0]] = stop
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// rootStopID returns the parent station of platforms, other stops are their own root
+	rootStopID := func(stopID string) string {
+		if stop, found := stops[stopID]; found && stop.parent != "" {
+			if _, found := stops[stop.parent]; found {
+				return stop.parent
+			}
+		}
+		return stopID
+	}
+
+	for stopID := range stops {
+		if root := rootStopID(stopID); root != stopID {
+			stops[root].childStopIDs = append(stops[root].childStopIDs, stopID)
+		}
+	}
+
+	// stop_times.txt is by far the largest file, it is streamed and only the served routes per station are kept
+	err = forEachGTFSRow(archive, "stop_times.txt", []string{"trip_id", "stop_id"}, func(row []string) {
+		name, found := tripRoutes[row[0]]
+		if !found {
+			return
+		}
+		if stop, found := stops[rootStopID(row[1])]; found {
+			stop.servedRoutes[name] = struct{}{}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only stations served by an imported route take part in the matching
+	candidates := map[string][]string{}
+	for stopID, stop := range stops {
+		if rootStopID(stopID) == stopID && len(stop.servedRoutes) > 0 {
+			key := normalizeStopName(stop.name)
+			candidates[key] = append(candidates[key], stopID)
+		}
+	}
+
+	adapter := &GTFSStaticAdapter{
+		details:  map[int]domains.StationDetails{},
+		routeIDs: routeIDs,
+	}
+	matched := map[string]bool{}
+
+	for _, station := range stations {
+		var stopID string
+		if override, found := options.Overrides[station.ID]; found {
+			if _, found := stops[override]; found {
+				stopID = rootStopID(override)
+			} else {
+				// Overrides may be written for another version of the feed, the station is matched by name instead
+				adapter.invalidOverrides = append(adapter.invalidOverrides, fmt.Sprintf("%d (%s)", station.ID, override))
+			}
+		}
+		if stopID == "" {
+			stopID = bestCandidate(candidates[normalizeStopName(station.Name)], stops)
+		}
+		if stopID == "" {
+			continue
+		}
+
+		matched[stopID] = true
+		adapter.details[station.ID] = stationDetails(stopID, stops)
+	}
+
+	for _, stopIDs := range candidates {
+		for _, stopID := range stopIDs {
+			if !matched[stopID] {
+				adapter.unmatched = append(adapter.unmatched, fmt.Sprintf("%s (%s)", stops[stopID].name, stopID))
+			}
+		}
+	}
+	sort.Strings(adapter.unmatched)
+	sort.Strings(adapter.invalidOverrides)
+
+	return adapter, nil
+}
+
+func (adapter *GTFSStaticAdapter) GetStationDetails(ctx context.Context, stationID int) (domains.StationDetails, bool) {
+	details, found := adapter.details[stationID]
+	return details, found
+}
+
+// Matched returns the number of KVB stations matched to a GTFS stop
+func (adapter *GTFSStaticAdapter) Matched() int {
+	return len(adapter.details)
+}
+
+// Unmatched returns the served GTFS stations no KVB station was matched to
+func (adapter *GTFSStaticAdapter) Unmatched() []string {
+	return adapter.unmatched
+}
+
+// InvalidOverrides returns the overrides referencing stop_ids missing in the feed, they were ignored
+func (adapter *GTFSStaticAdapter) InvalidOverrides() []string {
+	return adapter.invalidOverrides
+}
+
+// StopIDs returns the matched GTFS stop_id for every matched KVB station ID
+func (adapter *GTFSStaticAdapter) StopIDs() map[int]string {
+	stopIDs := make(map[int]string, len(adapter.details))
+	for stationID, details := range adapter.details {
+		stopIDs[stationID] = details.StopID
+	}
+	return stopIDs
+}
+
+// RouteIDs returns the GTFS route_id for every imported route short name
+func (adapter *GTFSStaticAdapter) RouteIDs() map[string]string {
+	return adapter.routeIDs
+}
+
+// bestCandidate picks the station served by most routes if multiple stations share a name, e.g. in other cities
+func bestCandidate(stopIDs []string, stops map[string]*gtfsStop) string {
+	best := ""
+	for _, stopID := range stopIDs {
+		if best == "" ||
+			len(stops[stopID].servedRoutes) > len(stops[best].servedRoutes) ||
+			len(stops[stopID].servedRoutes) == len(stops[best].servedRoutes) && stopID < best {
+			best = stopID
+		}
+	}
+	return best
+}
+
+func stationDetails(stopID string, stops map[string]*gtfsStop) domains.StationDetails {
+	stop := stops[stopID]
+	details := domains.StationDetails{
+		StopID:               stopID,
+		Latitude:             stop.latitude,
+		Longitude:            stop.longitude,
+		WheelchairAccessible: wheelchairBoarding(stop.wheelchair),
+	}
+
+	// Parent stations without location or accessibility inherit them from their platforms
+	var latitude, longitude float64
+	var located int
+	var accessible, inaccessible bool
+	for _, childID := range stop.childStopIDs {
+		child := stops[childID]
+		if child.hasLocation {
+			latitude += child.latitude
+			longitude += child.longitude
+			located++
+		}
+		if value := wheelchairBoarding(child.wheelchair); value != nil {
+			accessible = accessible || *value
+			inaccessible = inaccessible || !*value
+		}
+	}
+	if !stop.hasLocation && located > 0 {
+		details.Latitude = latitude / float64(located)
+		details.Longitude = longitude / float64(located)
+	}
+	if details.WheelchairAccessible == nil && (accessible || inaccessible) {
+		// A station is only accessible if all of its platforms with known accessibility are
+		value := accessible && !inaccessible
+		details.WheelchairAccessible = &value
+	}
+
+	details.Routes = make([]string, 0, len(stop.servedRoutes))
+	for name := range stop.servedRoutes {
+		details.Routes = append(details.Routes, name)
+	}
+	sort.Slice(details.Routes, func(i, j int) bool {
+		return lineLess(details.Routes[i], details.Routes[j])
+	})
+
+	return details
+}
+
+// wheelchairBoarding converts the GTFS wheelchair_boarding value, 0 and missing values are unknown
+func wheelchairBoarding(value string) *bool {
+	switch value {
+	case "1":
+		accessible := true
+		return &accessible
+	case "2":
+		accessible := false
+		return &accessible
+	default:
+		return nil
+	}
+}
+
+// lineLess orders numeric line names by number, so line 3 comes before line 12
+func lineLess(a, b string) bool {
+	numberA, errA := strconv.Atoi(a)
+	numberB, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return numberA < numberB
+	case errA == nil:
+		return true
+	case errB == nil:
+		return false
+	default:
+		return a < b
+	}
+}
+
+// normalizeStopName reduces a stop name to a form both KVB and GTFS names agree on, e.g.
+// "Köln Aachener Straße/Gürtel" and "Aachener Str./Gürtel" both become "aachenerstrguertel"
+func normalizeStopName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer("ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss").Replace(name)
+	for _, prefix := range []string{"koeln,", "koeln-", "koeln "} {
+		if strings.HasPrefix(name, prefix) {
+			name = strings.TrimSpace(strings.TrimPrefix(name, prefix))
+			break
+		}
+	}
+	name = strings.NewReplacer("strasse", "str", "hauptbahnhof", "hbf").Replace(name)
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// forEachGTFSRow streams the rows of a file of the feed, calling fn with the values of the requested columns in order
+func forEachGTFSRow(archive *zip.ReadCloser, name string, columns []string, fn func(row []string)) error {
+	var file *zip.File
+	for _, candidate := range archive.File {
+		// Some feeds put their files in a directory inside the zip
+		if path.Base(candidate.Name) == name {
+			file = candidate
+			break
+		}
+	}
+	if file == nil {
+		return fmt.Errorf("GTFS feed is missing %s", name)
+	}
+
+	content, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", name, err)
+	}
+	defer content.Close()
+
+	reader := csv.NewReader(content)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("reading header of %s: %w", name, err)
+	}
+
+	indexes := make([]int, len(columns))
+	for i, column := range columns {
+		indexes[i] = -1
+		for j, field := range header {
+			if strings.TrimSpace(strings.TrimPrefix(field, "\ufeff")) == column {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] == -1 && !optionalGTFSColumns[column] {
+			return fmt.Errorf("%s is missing column %s", name, column)
+		}
+	}
+
+	row := make([]string, len(columns))
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", name, err)
+		}
+
+		for i, index := range indexes {
+			row[i] = ""
+			if index >= 0 && index < len(record) {
+				row[i] = strings.TrimSpace(record[index])
+			}
+		}
+		fn(row)
+	}
+}
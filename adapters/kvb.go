@@ -3,10 +3,11 @@ package adapters
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/janritter/kvb-api/domains"
@@ -18,10 +19,30 @@ import (
 	"golang.org/x/text/encoding/charmap"
 )
 
-type KVBAdapter struct{}
+// DefaultKVBBaseURL is the KVB website the departure pages are requested from
+const DefaultKVBBaseURL = "https://www.kvb.koeln"
 
-func NewKVBAdapter() *KVBAdapter {
-	return &KVBAdapter{}
+type KVBAdapter struct {
+	baseURL string
+	client  *http.Client
+	logger  *slog.Logger
+}
+
+// NewKVBAdapter creates an adapter requesting the departure pages from baseURL through transport,
+// DefaultKVBBaseURL and http.DefaultTransport are used if they are empty
+func NewKVBAdapter(baseURL string, transport http.RoundTripper, logger *slog.Logger) *KVBAdapter {
+	if baseURL == "" {
+		baseURL = DefaultKVBBaseURL
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &KVBAdapter{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Transport: otelhttp.NewTransport(transport)},
+		logger:  logger,
+	}
 }
 
 func (adapter *KVBAdapter) GetDeparturesForStationID(ctx context.Context, stationID int) (domains.Departures, error) {
@@ -31,13 +52,21 @@ func (adapter *KVBAdapter) GetDeparturesForStationID(ctx context.Context, statio
 
 	span.SetAttributes(attribute.Int("stationID", stationID))
 
-	url := fmt.Sprintf("https://www.kvb.koeln/generated/?aktion=show&code=%d", stationID)
+	url := fmt.Sprintf("%s/generated/?aktion=show&code=%d", adapter.baseURL, stationID)
 
-	client := http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
 	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
-	res, err := client.Do(req)
+	res, err := adapter.client.Do(req)
 	if err != nil {
-		log.Println(err)
+		adapter.logger.ErrorContext(ctx, "Error requesting departures", slog.Int("stationID", stationID), slog.Any("error", err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domains.Departures{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		err = &domains.UpstreamStatusError{StatusCode: res.StatusCode}
+		adapter.logger.ErrorContext(ctx, "Unexpected status code requesting departures", slog.Int("stationID", stationID), slog.Int("status", res.StatusCode))
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return domains.Departures{}, err
@@ -45,7 +74,7 @@ func (adapter *KVBAdapter) GetDeparturesForStationID(ctx context.Context, statio
 
 	doc, err := goquery.NewDocumentFromReader(res.Body)
 	if err != nil {
-		log.Println(err)
+		adapter.logger.ErrorContext(ctx, "Error parsing departures page", slog.Int("stationID", stationID), slog.Any("error", err))
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return domains.Departures{}, err
@@ -53,32 +82,39 @@ func (adapter *KVBAdapter) GetDeparturesForStationID(ctx context.Context, statio
 
 	departures := []domains.Departure{}
 
-	_, span = otel.Tracer("kvb-api").Start(ctx, "GetDeparturesForStationID.Parse")
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetDeparturesForStationID.Parse")
 	defer span.End()
 	doc.Find("body > div > table:nth-child(2) > tbody > tr").Each(func(i int, s *goquery.Selection) {
 		if i != 0 {
 			route := strings.Trim(s.Find("td:nth-child(1)").Text(), " ")
 			destination := strings.Trim(s.Find("td:nth-child(2)").Text(), " ")
 			arrivalTimeString := s.Find("td:nth-child(3)").Text()
+			if strings.TrimSpace(route) == "" || strings.TrimSpace(destination) == "" {
+				adapter.logger.WarnContext(ctx, "Skipping departure without line or destination", slog.String("line", route), slog.String("destination", destination))
+				return
+			}
 
 			// Build correct time from Sofort and 2 Min
-			arrivalTime := -1
+			arrivalTime := domains.UnknownArrival
 			if strings.TrimSpace(arrivalTimeString) == "Sofort" {
 				arrivalTime = 0
 			} else {
 				arrivalTimeString = strings.Replace(arrivalTimeString, "Min", "", -1)
-				arrivalTime, err = strconv.Atoi(strings.TrimSpace(arrivalTimeString))
+				minutes, err := strconv.Atoi(strings.TrimSpace(arrivalTimeString))
 				if err != nil {
-					log.Println(err)
+					// Keep the arrival unknown, Atoi returns 0 on errors which would look like Sofort
+					adapter.logger.WarnContext(ctx, "Error parsing arrival time", slog.String("arrival", arrivalTimeString), slog.Any("error", err))
 					span.RecordError(err)
 					span.SetStatus(codes.Error, err.Error())
+				} else {
+					arrivalTime = minutes
 				}
 			}
 
 			// Response is ISO-8859-1, transfer to utf-8
 			destination, err = charmap.ISO8859_1.NewDecoder().String(destination)
 			if err != nil {
-				log.Println(err)
+				adapter.logger.WarnContext(ctx, "Error decoding destination", slog.Any("error", err))
 				span.RecordError(err)
 				span.SetStatus(codes.Error, err.Error())
 			}
@@ -94,5 +130,6 @@ func (adapter *KVBAdapter) GetDeparturesForStationID(ctx context.Context, statio
 
 	return domains.Departures{
 		Departures: departures,
+		FetchedAt:  time.Now(),
 	}, nil
 }
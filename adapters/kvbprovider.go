@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/logging"
+	"github.com/janritter/kvb-api/ports"
+)
+
+// KVBProvider serves KVB as departure provider, departures are scraped by the KVB adapter chain and stations are
+// taken from the KVB station list
+type KVBProvider struct {
+	kvbAdapter           ports.KVBAdapter
+	stationMapperAdapter ports.StationMapperAdapter
+}
+
+func NewKVBProvider(kvbAdapter ports.KVBAdapter, stationMapperAdapter ports.StationMapperAdapter) *KVBProvider {
+	return &KVBProvider{
+		kvbAdapter:           kvbAdapter,
+		stationMapperAdapter: stationMapperAdapter,
+	}
+}
+
+func (provider *KVBProvider) Name() string {
+	return domains.DefaultProvider
+}
+
+func (provider *KVBProvider) GetDepartures(ctx context.Context, stationID string) (domains.Departures, error) {
+	id, err := strconv.Atoi(stationID)
+	if err != nil {
+		return domains.Departures{}, fmt.Errorf("%w for ID %q", domains.ErrStationNotFound, stationID)
+	}
+	station, err := provider.stationMapperAdapter.GetStationForID(ctx, id)
+	if err != nil {
+		return domains.Departures{}, err
+	}
+
+	departures, err := provider.kvbAdapter.GetDeparturesForStationID(ctx, station.ID)
+	if err != nil {
+		return domains.Departures{}, err
+	}
+	logging.SetStation(ctx, station.Name, station.ID)
+	departures.Station = station.Name
+
+	return departures, nil
+}
+
+func (provider *KVBProvider) SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error) {
+	return provider.stationMapperAdapter.SearchStations(ctx, query, limit)
+}
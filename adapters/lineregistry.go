@@ -0,0 +1,82 @@
+package adapters
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+//go:embed data/lines.json
+var linesJSON []byte
+
+// modeColors are the colors of lines missing in the registry, KVB's network map draws all bus and all night bus lines
+// in one color each
+var modeColors = map[domains.LineMode][2]string{
+	domains.LineModeLightRail: {"#E3001B", "#FFFFFF"},
+	domains.LineModeBus:       {"#A5027D", "#FFFFFF"},
+	domains.LineModeNightBus:  {"#1B3C8C", "#FFFFFF"},
+}
+
+// LineRegistryAdapter provides the metadata of KVB lines from the embedded data/lines.json
+type LineRegistryAdapter struct {
+	lines map[string]domains.Line
+}
+
+func NewLineRegistryAdapter() (*LineRegistryAdapter, error) {
+	var lines []domains.Line
+	if err := json.Unmarshal(linesJSON, &lines); err != nil {
+		return nil, err
+	}
+
+	adapter := &LineRegistryAdapter{lines: map[string]domains.Line{}}
+	for _, line := range lines {
+		adapter.lines[line.Name] = line
+	}
+	return adapter, nil
+}
+
+// GetLines returns the lines of the registry ordered by name, numeric lines by number
+func (adapter *LineRegistryAdapter) GetLines(ctx context.Context) ([]domains.Line, error) {
+	lines := make([]domains.Line, 0, len(adapter.lines))
+	for _, line := range adapter.lines {
+		lines = append(lines, line)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return lineLess(lines[i].Name, lines[j].Name)
+	})
+
+	return lines, nil
+}
+
+// GetLine returns the line from the registry, lines missing in it are derived from their name.
+// KVB numbers Stadtbahn lines below 100 and bus lines from 100, night buses are prefixed with N.
+func (adapter *LineRegistryAdapter) GetLine(ctx context.Context, name string) (domains.Line, error) {
+	if line, found := adapter.lines[name]; found {
+		return line, nil
+	}
+
+	var mode domains.LineMode
+	if number, err := strconv.Atoi(name); err == nil && number > 0 {
+		mode = domains.LineModeLightRail
+		if number >= 100 {
+			mode = domains.LineModeBus
+		}
+	} else if number, err := strconv.Atoi(strings.TrimPrefix(name, "N")); err == nil && number > 0 && strings.HasPrefix(name, "N") {
+		mode = domains.LineModeNightBus
+	} else {
+		return domains.Line{}, domains.ErrLineNotFound
+	}
+
+	return domains.Line{
+		Name:      name,
+		Mode:      mode,
+		Color:     modeColors[mode][0],
+		TextColor: modeColors[mode][1],
+		Terminals: []string{},
+	}, nil
+}
@@ -0,0 +1,107 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/ports"
+)
+
+// localBucketSweepInterval is how often Reserve drops full buckets, keys come from requests so the map must not grow forever
+const localBucketSweepInterval = time.Minute
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// LocalRateLimiter is an in-memory token bucket per key, it only limits the requests of a single instance
+type LocalRateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func NewLocalRateLimiter(ratePerSecond float64, burst int) (*LocalRateLimiter, error) {
+	if err := validateRateLimit(ratePerSecond, burst); err != nil {
+		return nil, err
+	}
+
+	return &LocalRateLimiter{
+		rate:      ratePerSecond,
+		burst:     float64(burst),
+		buckets:   map[string]*tokenBucket{},
+		lastSweep: time.Now(),
+	}, nil
+}
+
+func (limiter *LocalRateLimiter) Reserve(ctx context.Context, key string, maxWait time.Duration) (ports.Reservation, error) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(limiter.lastSweep) >= localBucketSweepInterval {
+		limiter.sweep(now)
+	}
+
+	bucket, found := limiter.buckets[key]
+	if !found {
+		bucket = &tokenBucket{tokens: limiter.burst, last: now}
+		limiter.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(limiter.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*limiter.rate)
+	bucket.last = now
+
+	// Tokens may go negative, which reserves a token that becomes available in the future
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return ports.Reservation{OK: true, Remaining: int(bucket.tokens)}, nil
+	}
+
+	wait := time.Duration(-bucket.tokens / limiter.rate * float64(time.Second))
+	if wait > maxWait {
+		bucket.tokens++
+		return ports.Reservation{OK: false, Wait: wait}, nil
+	}
+
+	return ports.Reservation{OK: true, Wait: wait}, nil
+}
+
+func (limiter *LocalRateLimiter) Cancel(ctx context.Context, key string) error {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if bucket, found := limiter.buckets[key]; found {
+		bucket.tokens = math.Min(limiter.burst, bucket.tokens+1)
+	}
+	return nil
+}
+
+// sweep drops the buckets that refilled completely, a missing bucket starts full so limits stay the same.
+// It must be called with mu held.
+func (limiter *LocalRateLimiter) sweep(now time.Time) {
+	for key, bucket := range limiter.buckets {
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*limiter.rate >= limiter.burst {
+			delete(limiter.buckets, key)
+		}
+	}
+	limiter.lastSweep = now
+}
+
+// validateRateLimit rejects limits the token buckets can't refill at
+func validateRateLimit(ratePerSecond float64, burst int) error {
+	if ratePerSecond <= 0 || math.IsNaN(ratePerSecond) || math.IsInf(ratePerSecond, 0) {
+		return fmt.Errorf("invalid rate limit %v, must be greater than 0", ratePerSecond)
+	}
+	if burst < 1 {
+		return fmt.Errorf("invalid rate limit burst %d, must be at least 1", burst)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+
+	mqttserver "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+)
+
+// EmbeddedMQTTBrokerOptions configures the embedded broker, without username and password it only accepts
+// connections from the local machine
+type EmbeddedMQTTBrokerOptions struct {
+	Address  string
+	Username string
+	Password string
+}
+
+// EmbeddedMQTTBroker is an in-process MQTT broker. It stands in for a real broker when developing and testing the
+// MQTT publisher without containers.
+type EmbeddedMQTTBroker struct {
+	server   *mqttserver.Server
+	listener *listeners.TCP
+}
+
+// StartEmbeddedMQTTBroker starts the broker. Without credentials it binds to loopback if the address has no host and
+// refuses other hosts, with credentials every client has to authenticate with them.
+func StartEmbeddedMQTTBroker(options EmbeddedMQTTBrokerOptions, logger *slog.Logger) (*EmbeddedMQTTBroker, error) {
+	host, port, err := net.SplitHostPort(options.Address)
+	if err != nil {
+		return nil, err
+	}
+	if (options.Username == "") != (options.Password == "") {
+		return nil, fmt.Errorf("embedded MQTT broker needs both username and password or neither")
+	}
+
+	server := mqttserver.New(&mqttserver.Options{
+		Logger: logger.With(slog.String("component", "mqtt-broker")),
+	})
+
+	if options.Username == "" {
+		if host == "" {
+			host = "127.0.0.1"
+		}
+		if !isLoopbackHost(host) {
+			return nil, fmt.Errorf("embedded MQTT broker without credentials must listen on a loopback address, not %q", host)
+		}
+		err = server.AddHook(new(auth.AllowHook), nil)
+	} else {
+		err = server.AddHook(new(auth.Hook), &auth.Options{
+			Ledger: &auth.Ledger{
+				Users: auth.Users{
+					options.Username: {Username: auth.RString(options.Username), Password: auth.RString(options.Password)},
+				},
+			},
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	listener := listeners.NewTCP("tcp", net.JoinHostPort(host, port), nil)
+	if err := server.AddListener(listener); err != nil {
+		return nil, err
+	}
+	if err := server.Serve(); err != nil {
+		return nil, err
+	}
+
+	return &EmbeddedMQTTBroker{server: server, listener: listener}, nil
+}
+
+// Address returns the address the broker listens on
+func (broker *EmbeddedMQTTBroker) Address() string {
+	return broker.listener.Address()
+}
+
+// Close disconnects all clients and stops listening
+func (broker *EmbeddedMQTTBroker) Close() error {
+	return broker.server.Close()
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
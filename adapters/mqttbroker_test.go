@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+func startTestBroker(t *testing.T, options EmbeddedMQTTBrokerOptions) *EmbeddedMQTTBroker {
+	t.Helper()
+
+	broker, err := StartEmbeddedMQTTBroker(options, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { broker.Close() })
+	return broker
+}
+
+func connectTestClient(broker *EmbeddedMQTTBroker, username string, password string) (mqtt.Client, error) {
+	client := mqtt.NewClient(mqtt.NewClientOptions().
+		AddBroker("tcp://" + broker.Address()).
+		SetClientID("test-" + username).
+		SetUsername(username).
+		SetPassword(password).
+		SetConnectTimeout(2 * time.Second))
+
+	token := client.Connect()
+	if !token.WaitTimeout(5 * time.Second) {
+		return nil, mqtt.ErrNotConnected
+	}
+	return client, token.Error()
+}
+
+func TestEmbeddedMQTTBrokerBindsToLoopbackWithoutCredentials(t *testing.T) {
+	broker := startTestBroker(t, EmbeddedMQTTBrokerOptions{Address: ":0"})
+
+	if !strings.HasPrefix(broker.Address(), "127.0.0.1:") {
+		t.Errorf("expected the broker to listen on loopback, got %s", broker.Address())
+	}
+
+	client, err := connectTestClient(broker, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Disconnect(0)
+}
+
+func TestEmbeddedMQTTBrokerRefusesPublicAddressWithoutCredentials(t *testing.T) {
+	for _, address := range []string{"0.0.0.0:0", "[::]:0", "192.168.1.10:1883"} {
+		if _, err := StartEmbeddedMQTTBroker(EmbeddedMQTTBrokerOptions{Address: address}, slog.New(slog.NewTextHandler(io.Discard, nil))); err == nil {
+			t.Errorf("expected an error for %s", address)
+		}
+	}
+}
+
+func TestEmbeddedMQTTBrokerRequiresBothCredentials(t *testing.T) {
+	if _, err := StartEmbeddedMQTTBroker(EmbeddedMQTTBrokerOptions{Address: "127.0.0.1:0", Username: "kvb"}, slog.New(slog.NewTextHandler(io.Discard, nil))); err == nil {
+		t.Error("expected an error for a username without password")
+	}
+}
+
+func TestEmbeddedMQTTBrokerAuthenticates(t *testing.T) {
+	broker := startTestBroker(t, EmbeddedMQTTBrokerOptions{Address: "127.0.0.1:0", Username: "kvb", Password: "secret"})
+
+	client, err := connectTestClient(broker, "kvb", "secret")
+	if err != nil {
+		t.Fatalf("expected the configured credentials to be accepted: %v", err)
+	}
+	client.Disconnect(0)
+
+	for _, credentials := range [][2]string{{"", ""}, {"kvb", "wrong"}, {"other", "secret"}} {
+		if client, err := connectTestClient(broker, credentials[0], credentials[1]); err == nil {
+			client.Disconnect(0)
+			t.Errorf("expected %q with password %q to be refused", credentials[0], credentials[1])
+		}
+	}
+}
+
+func TestEmbeddedMQTTBrokerDeliversRetainedMessages(t *testing.T) {
+	broker := startTestBroker(t, EmbeddedMQTTBrokerOptions{Address: "127.0.0.1:0"})
+
+	publisher, err := NewMQTTPublisher(MQTTOptions{
+		BrokerURL:   "tcp://" + broker.Address(),
+		ClientID:    "publisher",
+		StatusTopic: "kvb/status",
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer publisher.Close()
+
+	// The publisher connects in the background
+	for deadline := time.Now().Add(5 * time.Second); !publisher.client.IsConnectionOpen(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("publisher didn't connect")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := publisher.Publish(ctx, "kvb/neumarkt", []byte(`{"station":"Neumarkt"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := connectTestClient(broker, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect(0)
+
+	received := make(chan string, 1)
+	client.Subscribe("kvb/neumarkt", 0, func(client mqtt.Client, message mqtt.Message) {
+		received <- string(message.Payload())
+	}).Wait()
+
+	select {
+	case payload := <-received:
+		if payload != `{"station":"Neumarkt"}` {
+			t.Errorf("unexpected payload %s", payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("retained message wasn't delivered")
+	}
+}
+
+func TestEmbeddedMQTTBrokerClose(t *testing.T) {
+	broker, err := StartEmbeddedMQTTBroker(EmbeddedMQTTBrokerOptions{Address: "127.0.0.1:0"}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := broker.Address()
+
+	if err := broker.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	client := mqtt.NewClient(mqtt.NewClientOptions().AddBroker("tcp://" + address).SetConnectTimeout(time.Second))
+	if token := client.Connect(); token.WaitTimeout(3*time.Second) && token.Error() == nil {
+		client.Disconnect(0)
+		t.Error("expected the closed broker to refuse connections")
+	}
+}
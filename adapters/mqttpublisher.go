@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// MQTTOptions configures the connection to the MQTT broker
+type MQTTOptions struct {
+	BrokerURL string
+	ClientID  string
+	Username  string
+	Password  string
+	QoS       byte
+	// StatusTopic receives a retained "online" on connect and "offline" as last will when the connection drops
+	StatusTopic string
+}
+
+// MQTTPublisher publishes retained messages to an MQTT broker, reconnecting in the background if it is unavailable
+type MQTTPublisher struct {
+	client  mqtt.Client
+	options MQTTOptions
+	logger  *slog.Logger
+}
+
+func NewMQTTPublisher(options MQTTOptions, logger *slog.Logger) (*MQTTPublisher, error) {
+	if options.QoS > 2 {
+		return nil, fmt.Errorf("invalid MQTT QoS %d, must be 0, 1 or 2", options.QoS)
+	}
+
+	publisher := &MQTTPublisher{
+		options: options,
+		logger:  logger,
+	}
+
+	clientOptions := mqtt.NewClientOptions().
+		AddBroker(options.BrokerURL).
+		SetClientID(options.ClientID).
+		SetUsername(options.Username).
+		SetPassword(options.Password).
+		SetAutoReconnect(true).
+		// The broker may start after the API, keep trying instead of failing at startup
+		SetConnectRetry(true).
+		SetWill(options.StatusTopic, "offline", options.QoS, true).
+		SetOnConnectHandler(func(client mqtt.Client) {
+			logger.Info("Connected to MQTT broker", slog.String("broker", options.BrokerURL))
+			client.Publish(options.StatusTopic, options.QoS, true, "online")
+		}).
+		SetConnectionLostHandler(func(client mqtt.Client, err error) {
+			logger.Warn("Lost connection to MQTT broker", slog.String("broker", options.BrokerURL), slog.Any("error", err))
+		})
+
+	publisher.client = mqtt.NewClient(clientOptions)
+	publisher.client.Connect()
+
+	return publisher, nil
+}
+
+func (publisher *MQTTPublisher) Publish(ctx context.Context, topic string, payload []byte) error {
+	token := publisher.client.Publish(topic, publisher.options.QoS, true, payload)
+
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close marks the publisher as offline and disconnects from the broker
+func (publisher *MQTTPublisher) Close() {
+	if publisher.client.IsConnected() {
+		publisher.client.Publish(publisher.options.StatusTopic, publisher.options.QoS, true, "offline").WaitTimeout(time.Second)
+	}
+	publisher.client.Disconnect(250)
+}
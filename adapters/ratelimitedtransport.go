@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/metrics"
+	"github.com/janritter/kvb-api/ports"
+)
+
+// RateLimitedTransport limits outgoing requests globally and per station, the station is taken from the
+// stationParameter query parameter, e.g. "code" of KVB URLs or "name_dm" of EFA departure monitor requests.
+// Requests wait up to maxWait for a token, otherwise they fail with a domains.RateLimitedError.
+// If a limiter itself fails, e.g. because Redis is unreachable, requests are let through.
+type RateLimitedTransport struct {
+	next             http.RoundTripper
+	global           ports.RateLimiter
+	perStation       ports.RateLimiter
+	stationParameter string
+	maxWait          time.Duration
+	logger           *slog.Logger
+}
+
+func NewRateLimitedTransport(next http.RoundTripper, global ports.RateLimiter, perStation ports.RateLimiter, stationParameter string, maxWait time.Duration, logger *slog.Logger) *RateLimitedTransport {
+	return &RateLimitedTransport{
+		next:             next,
+		global:           global,
+		perStation:       perStation,
+		stationParameter: stationParameter,
+		maxWait:          maxWait,
+		logger:           logger,
+	}
+}
+
+func (transport *RateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// The station limit is checked first, so a busy station does not use up global tokens it is not allowed to use.
+	// If the global limit rejects the request, the station token is returned.
+	var stationWait time.Duration
+	stationKey := ""
+	if station := req.URL.Query().Get(transport.stationParameter); station != "" && transport.perStation != nil {
+		stationKey = "station:" + station
+		wait, err := transport.reserve(ctx, transport.perStation, "station", stationKey)
+		if err != nil {
+			return nil, err
+		}
+		stationWait = wait
+	}
+
+	var globalWait time.Duration
+	if transport.global != nil {
+		wait, err := transport.reserve(ctx, transport.global, "global", "global")
+		if err != nil {
+			if stationKey != "" {
+				transport.cancel(ctx, transport.perStation, "station", stationKey)
+			}
+			return nil, err
+		}
+		globalWait = wait
+	}
+
+	// Both tokens are reserved, so waiting for the later one is enough
+	if wait := max(stationWait, globalWait); wait > 0 {
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+
+	return transport.next.RoundTrip(req)
+}
+
+// reserve returns the time to wait for a token of key, or a domains.RateLimitedError if none is available in time
+func (transport *RateLimitedTransport) reserve(ctx context.Context, limiter ports.RateLimiter, scope string, key string) (time.Duration, error) {
+	reservation, err := limiter.Reserve(ctx, key, transport.maxWait)
+	if err != nil {
+		transport.logger.WarnContext(ctx, "Error reserving rate limit token, letting request through", slog.String("scope", scope), slog.Any("error", err))
+		return 0, nil
+	}
+	if !reservation.OK {
+		metrics.UpstreamRateLimited.WithLabelValues(scope).Inc()
+		return 0, &domains.RateLimitedError{Scope: scope, RetryAfter: reservation.Wait}
+	}
+
+	return reservation.Wait, nil
+}
+
+func (transport *RateLimitedTransport) cancel(ctx context.Context, limiter ports.RateLimiter, scope string, key string) {
+	if err := limiter.Cancel(ctx, key); err != nil {
+		transport.logger.WarnContext(ctx, "Error returning rate limit token", slog.String("scope", scope), slog.Any("error", err))
+	}
+}
@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/metrics"
+	"github.com/janritter/kvb-api/ports"
+)
+
+// recordingQueueSize is the number of snapshots waiting to be written before new ones are dropped
+const recordingQueueSize = 256
+
+// RecordingKVBAdapter stores every departure result of the wrapped adapter in the history.
+// Snapshots are written in the background, a slow store never delays responses.
+type RecordingKVBAdapter struct {
+	next       ports.KVBAdapter
+	repository ports.DepartureHistoryRepository
+	snapshots  chan domains.DepartureSnapshot
+	logger     *slog.Logger
+}
+
+func NewRecordingKVBAdapter(next ports.KVBAdapter, repository ports.DepartureHistoryRepository, logger *slog.Logger) *RecordingKVBAdapter {
+	adapter := &RecordingKVBAdapter{
+		next:       next,
+		repository: repository,
+		snapshots:  make(chan domains.DepartureSnapshot, recordingQueueSize),
+		logger:     logger,
+	}
+	go adapter.write()
+
+	return adapter
+}
+
+func (adapter *RecordingKVBAdapter) GetDeparturesForStationID(ctx context.Context, stationID int) (domains.Departures, error) {
+	departures, err := adapter.next.GetDeparturesForStationID(ctx, stationID)
+	if err != nil {
+		return departures, err
+	}
+
+	snapshot := domains.DepartureSnapshot{
+		StationID:  stationID,
+		FetchedAt:  departures.FetchedAt,
+		Departures: departures.Departures,
+	}
+	select {
+	case adapter.snapshots <- snapshot:
+	default:
+		metrics.HistorySnapshots.WithLabelValues("dropped").Inc()
+		adapter.logger.WarnContext(ctx, "History queue full, dropping departure snapshot", slog.Int("stationID", stationID))
+	}
+
+	return departures, nil
+}
+
+func (adapter *RecordingKVBAdapter) write() {
+	for snapshot := range adapter.snapshots {
+		if err := adapter.repository.SaveSnapshot(context.Background(), snapshot); err != nil {
+			metrics.HistorySnapshots.WithLabelValues("failed").Inc()
+			adapter.logger.Error("Error recording departure snapshot", slog.Int("stationID", snapshot.StationID), slog.Any("error", err))
+			continue
+		}
+		metrics.HistorySnapshots.WithLabelValues("recorded").Inc()
+	}
+}
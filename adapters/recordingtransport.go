@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// recordingTimeFormat names recordings so their file names sort chronologically
+const recordingTimeFormat = "20060102T150405.000000000Z"
+
+// RecordingTransport writes every KVB response unchanged, status line and headers followed by the ISO-8859-1 body,
+// to <dir>/<station>/<time>.http. Recordings are served again by the ReplayTransport.
+type RecordingTransport struct {
+	next   http.RoundTripper
+	dir    string
+	logger *slog.Logger
+}
+
+func NewRecordingTransport(next http.RoundTripper, dir string, logger *slog.Logger) *RecordingTransport {
+	return &RecordingTransport{
+		next:   next,
+		dir:    dir,
+		logger: logger,
+	}
+}
+
+func (transport *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := transport.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	// DumpResponse restores the body it reads, the response stays usable for the caller
+	dump, err := httputil.DumpResponse(res, true)
+	if err != nil {
+		transport.logger.WarnContext(req.Context(), "Error reading KVB response for recording", slog.Any("error", err))
+		return res, nil
+	}
+
+	station := recordingStation(req)
+	stationDir := filepath.Join(transport.dir, station)
+	path := filepath.Join(stationDir, time.Now().UTC().Format(recordingTimeFormat)+".http")
+	if err := os.MkdirAll(stationDir, 0o755); err != nil {
+		transport.logger.WarnContext(req.Context(), "Error creating recording directory", slog.String("path", stationDir), slog.Any("error", err))
+		return res, nil
+	}
+	if err := os.WriteFile(path, dump, 0o644); err != nil {
+		transport.logger.WarnContext(req.Context(), "Error writing KVB recording", slog.String("path", path), slog.Any("error", err))
+		return res, nil
+	}
+
+	transport.logger.DebugContext(req.Context(), "Recorded KVB response", slog.String("station", station), slog.String("path", path))
+	return res, nil
+}
+
+// recordingStation returns the station code of a KVB request, codes are only used as directory names if they are numbers
+func recordingStation(req *http.Request) string {
+	code := req.URL.Query().Get("code")
+	if _, err := strconv.Atoi(code); err != nil {
+		return "unknown"
+	}
+	return code
+}
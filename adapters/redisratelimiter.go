@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/janritter/kvb-api/ports"
+	"github.com/redis/go-redis/v9"
+)
+
+// reserveScript implements the same token bucket as LocalRateLimiter atomically in Redis.
+// It returns the wait in milliseconds, 1 if the token was reserved or 0 otherwise and the remaining tokens.
+var reserveScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local max_wait = tonumber(ARGV[4])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "last")
+local tokens = tonumber(bucket[1]) or burst
+local last = tonumber(bucket[2]) or now
+
+tokens = math.min(burst, tokens + math.max(0, now - last) / 1000 * rate) - 1
+
+local wait = 0
+local ok = 1
+if tokens < 0 then
+	wait = math.ceil(-tokens / rate * 1000)
+	if wait > max_wait then
+		tokens = tokens + 1
+		ok = 0
+	end
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "last", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000) + max_wait)
+
+return {wait, ok, math.max(0, math.floor(tokens))}
+`)
+
+// cancelScript returns a reserved token without exceeding the burst
+var cancelScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local tokens = tonumber(redis.call("HGET", KEYS[1], "tokens"))
+if tokens then
+	redis.call("HSET", KEYS[1], "tokens", tostring(math.min(burst, tokens + 1)))
+end
+return 0
+`)
+
+// RedisRateLimiter keeps the token buckets in Redis so all replicas share the same limits
+type RedisRateLimiter struct {
+	client *redis.Client
+	prefix string
+	rate   float64
+	burst  int
+}
+
+func NewRedisRateLimiter(client *redis.Client, prefix string, ratePerSecond float64, burst int) (*RedisRateLimiter, error) {
+	if err := validateRateLimit(ratePerSecond, burst); err != nil {
+		return nil, err
+	}
+
+	return &RedisRateLimiter{
+		client: client,
+		prefix: prefix,
+		rate:   ratePerSecond,
+		burst:  burst,
+	}, nil
+}
+
+func (limiter *RedisRateLimiter) Reserve(ctx context.Context, key string, maxWait time.Duration) (ports.Reservation, error) {
+	result, err := reserveScript.Run(ctx, limiter.client,
+		[]string{limiter.prefix + key},
+		limiter.rate, limiter.burst, time.Now().UnixMilli(), maxWait.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return ports.Reservation{}, err
+	}
+
+	return ports.Reservation{
+		OK:        result[1] == 1,
+		Wait:      time.Duration(result[0]) * time.Millisecond,
+		Remaining: int(result[2]),
+	}, nil
+}
+
+func (limiter *RedisRateLimiter) Cancel(ctx context.Context, key string) error {
+	return cancelScript.Run(ctx, limiter.client, []string{limiter.prefix + key}, limiter.burst).Err()
+}
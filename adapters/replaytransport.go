@@ -0,0 +1,101 @@
+package adapters
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// ReplayTransport answers KVB requests with the recordings of a RecordingTransport instead of requesting KVB.
+// The recordings of a station are served in the order they were recorded, the last one is repeated once all were served.
+// Stations without recordings are answered with 404 Not Found.
+type ReplayTransport struct {
+	mu sync.Mutex
+	// recordings holds the recording files per station, oldest first
+	recordings map[string][]string
+	// served holds the number of recordings served per station
+	served map[string]int
+}
+
+// NewReplayTransport indexes the recordings in dir
+func NewReplayTransport(dir string) (*ReplayTransport, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*", "*.http"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no recordings found in %s", dir)
+	}
+	sort.Strings(paths)
+
+	recordings := map[string][]string{}
+	for _, path := range paths {
+		station := filepath.Base(filepath.Dir(path))
+		recordings[station] = append(recordings[station], path)
+	}
+
+	return &ReplayTransport{
+		recordings: recordings,
+		served:     map[string]int{},
+	}, nil
+}
+
+// NewReplayKVBAdapter creates a KVBAdapter parsing the recordings in dir, so the API runs offline and deterministically
+func NewReplayKVBAdapter(dir string, logger *slog.Logger) (*KVBAdapter, error) {
+	transport, err := NewReplayTransport(dir)
+	if err != nil {
+		return nil, err
+	}
+	return NewKVBAdapter("", transport, logger), nil
+}
+
+func (transport *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path, found := transport.next(recordingStation(req))
+	if !found {
+		return &http.Response{
+			Status:        "404 Not Found",
+			StatusCode:    http.StatusNotFound,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"text/plain"}},
+			Body:          http.NoBody,
+			ContentLength: 0,
+			Request:       req,
+		}, nil
+	}
+
+	dump, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading recording: %w", err)
+	}
+	res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(dump)), req)
+	if err != nil {
+		return nil, fmt.Errorf("parsing recording %s: %w", path, err)
+	}
+	return res, nil
+}
+
+func (transport *ReplayTransport) next(station string) (string, bool) {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	recordings := transport.recordings[station]
+	if len(recordings) == 0 {
+		return "", false
+	}
+
+	i := transport.served[station]
+	if i >= len(recordings) {
+		i = len(recordings) - 1
+	} else {
+		transport.served[station]++
+	}
+	return recordings[i], true
+}
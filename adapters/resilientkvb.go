@@ -0,0 +1,211 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/metrics"
+	"github.com/janritter/kvb-api/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ResilienceOptions struct {
+	// MaxAttempts is the total number of attempts including the first request
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// AttemptTimeout bounds every single attempt, zero disables it
+	AttemptTimeout time.Duration
+
+	FailureThreshold int
+	Cooldown         time.Duration
+}
+
+// ResilientKVBAdapter retries transient upstream errors with jittered exponential backoff and fails fast while its circuit breaker is open
+type ResilientKVBAdapter struct {
+	next    ports.KVBAdapter
+	options ResilienceOptions
+	breaker *circuitBreaker
+	logger  *slog.Logger
+}
+
+func NewResilientKVBAdapter(next ports.KVBAdapter, options ResilienceOptions, logger *slog.Logger) *ResilientKVBAdapter {
+	if options.MaxAttempts < 1 {
+		options.MaxAttempts = 1
+	}
+	if options.FailureThreshold < 1 {
+		options.FailureThreshold = 1
+	}
+
+	return &ResilientKVBAdapter{
+		next:    next,
+		options: options,
+		breaker: newCircuitBreaker("kvb", options.FailureThreshold, options.Cooldown),
+		logger:  logger,
+	}
+}
+
+func (adapter *ResilientKVBAdapter) GetDeparturesForStationID(ctx context.Context, stationID int) (domains.Departures, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "ResilientKVBAdapter.GetDeparturesForStationID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stationID", stationID))
+
+	var err, lastTransientErr error
+	for attempt := 1; attempt <= adapter.options.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			metrics.UpstreamRetries.WithLabelValues("kvb").Inc()
+			if err := sleep(ctx, adapter.backoff(attempt-1)); err != nil {
+				break
+			}
+		}
+
+		if !adapter.breaker.allow() {
+			err = domains.ErrCircuitOpen
+			metrics.UpstreamRequests.WithLabelValues("kvb", "rejected").Inc()
+			break
+		}
+
+		var departures domains.Departures
+		departures, err = adapter.attempt(ctx, stationID)
+		span.SetAttributes(attribute.Int("attempts", attempt))
+
+		switch {
+		case err == nil:
+			adapter.breaker.success()
+			metrics.UpstreamRequests.WithLabelValues("kvb", "success").Inc()
+			return departures, nil
+		case ctx.Err() != nil:
+			// The caller gave up, this says nothing about the upstream
+			adapter.breaker.release()
+			metrics.UpstreamRequests.WithLabelValues("kvb", "cancelled").Inc()
+			return domains.Departures{}, err
+		case isRateLimited(err):
+			// The request never left this instance
+			adapter.breaker.release()
+			metrics.UpstreamRequests.WithLabelValues("kvb", "rate_limited").Inc()
+			// A retry running into the limit must not hide why the request failed before
+			if lastTransientErr != nil {
+				return domains.Departures{}, lastTransientErr
+			}
+			return domains.Departures{}, err
+		case !isTransient(err):
+			// The upstream answered, so it is reachable even though the request failed
+			adapter.breaker.success()
+			metrics.UpstreamRequests.WithLabelValues("kvb", "error").Inc()
+			return domains.Departures{}, err
+		}
+
+		lastTransientErr = err
+		adapter.breaker.failure()
+		metrics.UpstreamRequests.WithLabelValues("kvb", "transient_error").Inc()
+		adapter.logger.WarnContext(ctx, "Transient error requesting departures",
+			slog.Int("stationID", stationID),
+			slog.Int("attempt", attempt),
+			slog.Any("error", err),
+		)
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return domains.Departures{}, err
+}
+
+// BreakerState returns the current state of the circuit breaker
+func (adapter *ResilientKVBAdapter) BreakerState() BreakerState {
+	return adapter.breaker.State()
+}
+
+func (adapter *ResilientKVBAdapter) attempt(ctx context.Context, stationID int) (domains.Departures, error) {
+	if adapter.options.AttemptTimeout <= 0 {
+		return adapter.next.GetDeparturesForStationID(ctx, stationID)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, adapter.options.AttemptTimeout)
+	defer cancel()
+
+	return adapter.next.GetDeparturesForStationID(attemptCtx, stationID)
+}
+
+func (adapter *ResilientKVBAdapter) backoff(retry int) time.Duration {
+	return jitteredBackoff(retry, adapter.options.BaseDelay, adapter.options.MaxDelay)
+}
+
+// jitteredBackoff returns a random delay up to the exponential backoff for the given retry ("full jitter")
+func jitteredBackoff(retry int, baseDelay time.Duration, maxDelay time.Duration) time.Duration {
+	delay := baseDelay << (retry - 1)
+	if delay <= 0 || (maxDelay > 0 && delay > maxDelay) {
+		delay = maxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+func isRateLimited(err error) bool {
+	var rateLimitedErr *domains.RateLimitedError
+	return errors.As(err, &rateLimitedErr)
+}
+
+// isTransient reports whether an error is worth retrying: timeouts, connection errors and 5xx responses. Every error
+// of the HTTP client is a *url.Error implementing net.Error, so DNS, TLS and invalid URL errors are told apart by what
+// it wraps.
+func isTransient(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary
+	}
+
+	// Received TLS alerts are reported as operation "remote error"
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && (opErr.Op == "dial" || opErr.Op == "read" || opErr.Op == "write") {
+		return true
+	}
+
+	// The connection was closed before the response was complete
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var statusErr *domains.UpstreamStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode >= http.StatusInternalServerError {
+		return true
+	}
+
+	return false
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/metrics"
+	"github.com/janritter/kvb-api/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SourceOptions selects where departures of a KVB station are fetched from
+type SourceOptions struct {
+	Default domains.DepartureSource
+	// Stations overrides the default source per KVB station ID
+	Stations map[int]domains.DepartureSource
+	// StopIDs maps KVB station IDs to EFA stop IDs, stations without stop ID are always scraped from the KVB website
+	StopIDs map[int]string
+}
+
+// SourceKVBAdapter fetches departures of KVB stations from the KVB website or EFA, whichever is configured for the
+// station, and falls back to the other source if it fails
+type SourceKVBAdapter struct {
+	html    ports.KVBAdapter
+	efa     *EFAAdapter
+	options SourceOptions
+	logger  *slog.Logger
+}
+
+func NewSourceKVBAdapter(html ports.KVBAdapter, efa *EFAAdapter, options SourceOptions, logger *slog.Logger) *SourceKVBAdapter {
+	if options.Default == "" {
+		options.Default = domains.DepartureSourceHTML
+	}
+
+	return &SourceKVBAdapter{
+		html:    html,
+		efa:     efa,
+		options: options,
+		logger:  logger,
+	}
+}
+
+func (adapter *SourceKVBAdapter) GetDeparturesForStationID(ctx context.Context, stationID int) (domains.Departures, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "SourceKVBAdapter.GetDeparturesForStationID")
+	defer span.End()
+
+	stopID, hasStopID := adapter.options.StopIDs[stationID]
+	source := adapter.source(stationID)
+	if source == domains.DepartureSourceEFA && !hasStopID {
+		source = domains.DepartureSourceHTML
+	}
+	span.SetAttributes(attribute.String("source", string(source)))
+
+	departures, err := adapter.fetch(ctx, source, stationID, stopID)
+	if err == nil || ctx.Err() != nil {
+		return departures, err
+	}
+
+	fallback := domains.DepartureSourceEFA
+	if source == domains.DepartureSourceEFA {
+		fallback = domains.DepartureSourceHTML
+	} else if !hasStopID {
+		return departures, err
+	}
+
+	adapter.logger.WarnContext(ctx, "Error getting departures, falling back to other source",
+		slog.Int("stationID", stationID), slog.String("source", string(source)), slog.String("fallback", string(fallback)), slog.Any("error", err))
+	metrics.DepartureSourceFallbacks.WithLabelValues(string(source), string(fallback)).Inc()
+	span.SetAttributes(attribute.String("fallback", string(fallback)))
+
+	fallbackDepartures, fallbackErr := adapter.fetch(ctx, fallback, stationID, stopID)
+	if fallbackErr != nil {
+		// The error of the configured source is the interesting one
+		return departures, err
+	}
+	return fallbackDepartures, nil
+}
+
+func (adapter *SourceKVBAdapter) source(stationID int) domains.DepartureSource {
+	if source, found := adapter.options.Stations[stationID]; found {
+		return source
+	}
+	return adapter.options.Default
+}
+
+func (adapter *SourceKVBAdapter) fetch(ctx context.Context, source domains.DepartureSource, stationID int, stopID string) (domains.Departures, error) {
+	if source == domains.DepartureSourceEFA {
+		return adapter.efa.GetDepartures(ctx, stopID)
+	}
+	return adapter.html.GetDeparturesForStationID(ctx, stationID)
+}
@@ -0,0 +1,182 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/metrics"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	webhookSignatureHeader = "X-Webhook-Signature"
+	webhookTimestampHeader = "X-Webhook-Timestamp"
+	webhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// WebhookSenderOptions configures the retries of webhook deliveries
+type WebhookSenderOptions struct {
+	// MaxAttempts is the total number of attempts including the first request
+	MaxAttempts    int
+	BaseDelay      time.Duration
+	MaxDelay       time.Duration
+	AttemptTimeout time.Duration
+	// AllowPrivateTargets permits webhooks to loopback, private and link-local addresses, e.g. for local development
+	AllowPrivateTargets bool
+}
+
+// HTTPWebhookSender posts HMAC signed payloads, retrying connection errors, 429 and 5xx responses with jittered backoff
+type HTTPWebhookSender struct {
+	client  *http.Client
+	options WebhookSenderOptions
+	logger  *slog.Logger
+}
+
+func NewHTTPWebhookSender(options WebhookSenderOptions, logger *slog.Logger) *HTTPWebhookSender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !options.AllowPrivateTargets {
+		// Checking the address of every connection also covers host names resolving differently than when the rule
+		// was validated. Proxies would hide the target from the check, so none are used.
+		transport.Proxy = nil
+		transport.DialContext = (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   publicAddressControl,
+		}).DialContext
+	}
+
+	return &HTTPWebhookSender{
+		client: &http.Client{
+			Transport: otelhttp.NewTransport(transport),
+			// Receivers redirecting elsewhere would get the payload without the caller knowing
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		options: options,
+		logger:  logger,
+	}
+}
+
+func (sender *HTTPWebhookSender) SendWebhook(ctx context.Context, url string, secret string, deliveryID string, payload []byte) error {
+	var err error
+	for attempt := 1; attempt <= max(1, sender.options.MaxAttempts); attempt++ {
+		if attempt > 1 {
+			delay := jitteredBackoff(attempt-1, sender.options.BaseDelay, sender.options.MaxDelay)
+			sender.logger.InfoContext(ctx, "Retrying webhook delivery", slog.String("delivery", deliveryID), slog.Int("attempt", attempt), slog.Duration("delay", delay), slog.Any("error", err))
+			if sleepErr := sleep(ctx, delay); sleepErr != nil {
+				return err
+			}
+		}
+
+		err = sender.attempt(ctx, url, secret, deliveryID, payload)
+		if err == nil {
+			metrics.WebhookDeliveries.WithLabelValues("delivered").Inc()
+			return nil
+		}
+		if errors.Is(err, domains.ErrWebhookTargetNotAllowed) || !isTransient(err) && !isRetryableStatus(err) {
+			break
+		}
+		metrics.WebhookDeliveries.WithLabelValues("retried").Inc()
+	}
+
+	metrics.WebhookDeliveries.WithLabelValues("failed").Inc()
+	return err
+}
+
+func (sender *HTTPWebhookSender) attempt(ctx context.Context, url string, secret string, deliveryID string, payload []byte) error {
+	if sender.options.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, sender.options.AttemptTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	// Signing the timestamp with the payload lets receivers reject replayed deliveries
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "kvb-api-webhooks")
+	req.Header.Set(webhookDeliveryHeader, deliveryID)
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, "sha256="+SignWebhook(secret, timestamp, payload))
+
+	res, err := sender.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return &domains.UpstreamStatusError{StatusCode: res.StatusCode}
+	}
+	return nil
+}
+
+// CheckWebhookURL resolves the host of the URL and refuses it if any of its addresses isn't public
+func (sender *HTTPWebhookSender) CheckWebhookURL(ctx context.Context, rawURL string) error {
+	if sender.options.AllowPrivateTargets {
+		return nil
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", domains.ErrInvalidWebhookRule)
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", parsed.Hostname())
+	if err != nil {
+		return fmt.Errorf("%w: host of url can't be resolved", domains.ErrInvalidWebhookRule)
+	}
+	for _, addr := range addrs {
+		if !domains.IsPublicAddress(addr) {
+			return fmt.Errorf("%w: url must not point to a loopback, private or link-local address", domains.ErrInvalidWebhookRule)
+		}
+	}
+	return nil
+}
+
+// publicAddressControl refuses connections to addresses which aren't public before they are established
+func publicAddressControl(network string, address string, conn syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !domains.IsPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", domains.ErrWebhookTargetNotAllowed, addrPort.Addr())
+	}
+	return nil
+}
+
+// SignWebhook returns the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" with the secret as key
+func SignWebhook(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s.", timestamp)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// isRetryableStatus reports whether the receiver asked to try again later
+func isRetryableStatus(err error) bool {
+	var statusErr *domains.UpstreamStatusError
+	return errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode == http.StatusRequestTimeout)
+}
@@ -0,0 +1,151 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/jsonfile"
+)
+
+// FileWebhookRuleRepository keeps webhook rules in memory and persists them as JSON file on every change.
+// The file is only readable by the owner, the rules contain the signing secrets.
+// The delivered departures are stored next to the rules, in a file named like the rules file with .deliveries.json.
+type FileWebhookRuleRepository struct {
+	path           string
+	deliveriesPath string
+	mu             sync.RWMutex
+	rules          map[string]domains.WebhookRule
+}
+
+// NewFileWebhookRuleRepository loads the rules stored at path, without path rules are only kept in memory
+func NewFileWebhookRuleRepository(path string) (*FileWebhookRuleRepository, error) {
+	repository := &FileWebhookRuleRepository{
+		path:  path,
+		rules: map[string]domains.WebhookRule{},
+	}
+	if path == "" {
+		return repository, nil
+	}
+	repository.deliveriesPath = strings.TrimSuffix(path, ".json") + ".deliveries.json"
+
+	var rules []domains.WebhookRule
+	if err := jsonfile.Read(path, &rules); err != nil {
+		return nil, fmt.Errorf("reading webhook rules: %w", err)
+	}
+	for _, rule := range rules {
+		repository.rules[rule.ID] = rule
+	}
+
+	return repository, nil
+}
+
+// ListWebhookRules returns all rules ordered by creation
+func (repository *FileWebhookRuleRepository) ListWebhookRules(ctx context.Context) ([]domains.WebhookRule, error) {
+	repository.mu.RLock()
+	defer repository.mu.RUnlock()
+
+	return repository.sortedRules(), nil
+}
+
+func (repository *FileWebhookRuleRepository) GetWebhookRule(ctx context.Context, id string) (domains.WebhookRule, error) {
+	repository.mu.RLock()
+	defer repository.mu.RUnlock()
+
+	rule, found := repository.rules[id]
+	if !found {
+		return domains.WebhookRule{}, domains.ErrWebhookRuleNotFound
+	}
+	return rule, nil
+}
+
+func (repository *FileWebhookRuleRepository) SaveWebhookRule(ctx context.Context, rule domains.WebhookRule) error {
+	repository.mu.Lock()
+	defer repository.mu.Unlock()
+
+	previous, existed := repository.rules[rule.ID]
+	repository.rules[rule.ID] = rule
+
+	if err := repository.persist(); err != nil {
+		// Keep memory and file in sync
+		if existed {
+			repository.rules[rule.ID] = previous
+		} else {
+			delete(repository.rules, rule.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (repository *FileWebhookRuleRepository) DeleteWebhookRule(ctx context.Context, id string) error {
+	repository.mu.Lock()
+	defer repository.mu.Unlock()
+
+	rule, found := repository.rules[id]
+	if !found {
+		return domains.ErrWebhookRuleNotFound
+	}
+	delete(repository.rules, id)
+
+	if err := repository.persist(); err != nil {
+		repository.rules[id] = rule
+		return err
+	}
+	return nil
+}
+
+func (repository *FileWebhookRuleRepository) sortedRules() []domains.WebhookRule {
+	rules := make([]domains.WebhookRule, 0, len(repository.rules))
+	for _, rule := range repository.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].CreatedAt.Equal(rules[j].CreatedAt) {
+			return rules[i].ID < rules[j].ID
+		}
+		return rules[i].CreatedAt.Before(rules[j].CreatedAt)
+	})
+	return rules
+}
+
+// ListWebhookDeliveries returns the stored deliveries, without file there are none
+func (repository *FileWebhookRuleRepository) ListWebhookDeliveries(ctx context.Context) (map[string][]domains.WebhookDelivery, error) {
+	deliveries := map[string][]domains.WebhookDelivery{}
+	if repository.deliveriesPath == "" {
+		return deliveries, nil
+	}
+
+	if err := jsonfile.Read(repository.deliveriesPath, &deliveries); err != nil {
+		return nil, fmt.Errorf("reading webhook deliveries: %w", err)
+	}
+	if deliveries == nil {
+		deliveries = map[string][]domains.WebhookDelivery{}
+	}
+	return deliveries, nil
+}
+
+func (repository *FileWebhookRuleRepository) SaveWebhookDeliveries(ctx context.Context, deliveries map[string][]domains.WebhookDelivery) error {
+	if repository.deliveriesPath == "" {
+		return nil
+	}
+
+	if err := jsonfile.Write(repository.deliveriesPath, deliveries); err != nil {
+		return fmt.Errorf("writing webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+func (repository *FileWebhookRuleRepository) persist() error {
+	if repository.path == "" {
+		return nil
+	}
+
+	if err := jsonfile.Write(repository.path, repository.sortedRules()); err != nil {
+		return fmt.Errorf("writing webhook rules: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// fake-kvb serves generated departure pages like kvb.koeln, run the API against it with KVB_BASE_URL=http://localhost:8081
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/janritter/kvb-api/fakekvb"
+	"github.com/janritter/kvb-api/logging"
+)
+
+func main() {
+	address := flag.String("listen", ":8081", "address to listen on")
+	scenarioName := flag.String("scenario", string(fakekvb.ScenarioNormal), "scenario of all stations: normal, empty, error, slow, malformed or sofort")
+	slowDelay := flag.Duration("slow-delay", 5*time.Second, "delay of the slow scenario")
+	logLevel := flag.String("log-level", "info", "one of debug, info, warn, error")
+	flag.Parse()
+
+	logger := logging.New(os.Stdout, *logLevel, "text")
+
+	scenario, err := fakekvb.ParseScenario(*scenarioName)
+	if err != nil {
+		logger.Error("Invalid scenario", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	server, err := fakekvb.NewServer(fakekvb.Options{
+		Scenario:  scenario,
+		SlowDelay: *slowDelay,
+		Logger:    logger,
+	})
+	if err != nil {
+		logger.Error("Error creating fake KVB server", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	logger.Info("Running fake KVB server", slog.String("address", *address), slog.String("scenario", string(scenario)))
+	if err := http.ListenAndServe(*address, server); err != nil {
+		logger.Error("Fake KVB server stopped", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
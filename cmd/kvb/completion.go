@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/janritter/kvb-api/adapters"
+)
+
+const bashCompletion = `_kvb() {
+  local cur="${COMP_WORDS[COMP_CWORD]}"
+  if [[ "$cur" == -* ]]; then
+    COMPREPLY=($(compgen -W "--api --api-key --line --destination --limit --json --watch --interval --no-color" -- "$cur"))
+    return
+  fi
+  local IFS=$'\n'
+  COMPREPLY=($(kvb __complete "$cur"))
+  if [[ $COMP_CWORD -eq 1 ]]; then
+    COMPREPLY+=($(compgen -W $'stations\nsearch\nmonitor\ncompletion' -- "$cur"))
+  fi
+  COMPREPLY=("${COMPREPLY[@]// /\\ }")
+}
+complete -F _kvb kvb
+`
+
+const zshCompletion = `#compdef kvb
+_kvb() {
+  local -a stations
+  stations=("${(@f)$(kvb __complete "${words[CURRENT]}")}")
+  if (( CURRENT == 2 )); then
+    compadd stations search monitor completion
+  fi
+  compadd -a stations
+}
+compdef _kvb kvb
+`
+
+const fishCompletion = `complete -c kvb -f
+complete -c kvb -n '__fish_use_subcommand' -a 'stations search monitor completion'
+complete -c kvb -a '(kvb __complete (commandline -ct))'
+complete -c kvb -l api -r -d 'URL of a running kvb-api'
+complete -c kvb -l api-key -r -d 'API key of the kvb-api'
+complete -c kvb -l line -r -d 'Only show these lines'
+complete -c kvb -l destination -r -d 'Only show destinations containing the text'
+complete -c kvb -l limit -r -d 'Maximum number of departures'
+complete -c kvb -l json -d 'Print JSON'
+complete -c kvb -l watch -d 'Refresh the departures in place'
+complete -c kvb -l interval -r -d 'Refresh interval of --watch'
+complete -c kvb -l no-color -d "Don't color line badges"
+`
+
+func runCompletion(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "bash":
+		fmt.Print(bashCompletion)
+	case "zsh":
+		fmt.Print(zshCompletion)
+	case "fish":
+		fmt.Print(fishCompletion)
+	default:
+		return errors.New("unsupported shell " + args[0] + ", use bash, zsh or fish")
+	}
+	return nil
+}
+
+// runComplete prints the station names starting with the prefix, one per line. Station names are taken from the
+// station registry, so completion works offline and without an API.
+func runComplete(ctx context.Context, args []string) error {
+	prefix := ""
+	if len(args) > 0 {
+		prefix = strings.ToLower(strings.Join(args, " "))
+	}
+
+	stations, err := adapters.NewStationMapperAdapter().GetStations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, station := range stations {
+		if strings.HasPrefix(strings.ToLower(station.Name), prefix) {
+			fmt.Println(station.Name)
+		}
+	}
+	return nil
+}
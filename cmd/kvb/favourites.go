@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/janritter/kvb-api/jsonfile"
+)
+
+// favouritesFile is the content of the favourites file of the monitor
+type favouritesFile struct {
+	Stations []string `json:"stations"`
+}
+
+// favouritesPath returns KVB_FAVOURITES_FILE or favourites.json in the kvb directory of the user's config directory
+func favouritesPath() (string, error) {
+	if path := os.Getenv("KVB_FAVOURITES_FILE"); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "kvb", "favourites.json"), nil
+}
+
+// loadFavourites returns the favourite stations, none if the file doesn't exist yet
+func loadFavourites(path string) ([]string, error) {
+	var file favouritesFile
+	if err := jsonfile.Read(path, &file); err != nil {
+		return nil, fmt.Errorf("reading favourites %s: %w", path, err)
+	}
+	return file.Stations, nil
+}
+
+// saveFavourites replaces the favourites file atomically
+func saveFavourites(path string, stations []string) error {
+	if err := jsonfile.Write(path, favouritesFile{Stations: stations}); err != nil {
+		return fmt.Errorf("writing favourites: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+// kvb shows KVB departures in the terminal, either from a running API (--api or KVB_API_URL) or requested from KVB directly
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/logging"
+)
+
+const usage = `Usage:
+  kvb [flags] <station>           Show the departures of a station
+  kvb stations [flags]            List all stations
+  kvb search [flags] <query>      Search stations, best match first
+  kvb monitor [flags]             Full-screen monitor of your favourite stations
+  kvb completion bash|zsh|fish    Print the shell completion script
+
+Flags:
+  --api URL          Use a running kvb-api instead of requesting KVB directly (KVB_API_URL)
+  --api-key KEY      API key of the kvb-api (KVB_API_KEY)
+  --line LINE        Only show these lines, repeatable or comma separated
+  --destination TEXT Only show destinations containing the text
+  --limit N          Maximum number of departures or search results (default 10)
+  --json             Print JSON
+  --watch            Refresh the departures in place
+  --interval D       Refresh interval of --watch and the monitor (default 30s)
+  --no-color         Don't color line badges (NO_COLOR)
+`
+
+var errUsage = errors.New("invalid usage, see kvb --help")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "kvb:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	opts, positional, err := parseFlags(args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return errUsage
+	}
+
+	// Flags may come before or after the command, e.g. kvb --api http://localhost:8080 search neu
+	switch positional[0] {
+	case "help":
+		fmt.Print(usage)
+		return nil
+	case "stations":
+		return runStations(ctx, opts)
+	case "search":
+		return runSearch(ctx, opts, positional[1:])
+	case "monitor":
+		return runMonitor(ctx, opts)
+	case "completion":
+		return runCompletion(positional[1:])
+	case "__complete":
+		return runComplete(ctx, positional[1:])
+	case "departures":
+		return runDepartures(ctx, opts, positional[1:])
+	}
+	return runDepartures(ctx, opts, positional)
+}
+
+// options are the flags shared by all commands
+type options struct {
+	api         string
+	apiKey      string
+	lines       listFlag
+	destination string
+	limit       int
+	json        bool
+	watch       bool
+	interval    time.Duration
+	noColor     bool
+}
+
+func parseFlags(args []string) (options, []string, error) {
+	var opts options
+	flags := flag.NewFlagSet("kvb", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.StringVar(&opts.api, "api", os.Getenv("KVB_API_URL"), "")
+	flags.StringVar(&opts.apiKey, "api-key", os.Getenv("KVB_API_KEY"), "")
+	flags.Var(&opts.lines, "line", "")
+	flags.StringVar(&opts.destination, "destination", "", "")
+	flags.IntVar(&opts.limit, "limit", 10, "")
+	flags.BoolVar(&opts.json, "json", false, "")
+	flags.BoolVar(&opts.watch, "watch", false, "")
+	flags.DurationVar(&opts.interval, "interval", 30*time.Second, "")
+	flags.BoolVar(&opts.noColor, "no-color", os.Getenv("NO_COLOR") != "", "")
+
+	// Flags may follow positional arguments, e.g. kvb neumarkt --line 9
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				fmt.Print(usage)
+				os.Exit(0)
+			}
+			return options{}, nil, fmt.Errorf("%w, see kvb --help", err)
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+
+	if opts.interval < time.Second {
+		return options{}, nil, errors.New("--interval must be at least 1s")
+	}
+	return opts, positional, nil
+}
+
+func (opts options) printer() printer {
+	return printer{w: os.Stdout, color: !opts.noColor && isTerminal(os.Stdout)}
+}
+
+func (opts options) source() (source, error) {
+	// Parser warnings would garble the output, only errors are logged
+	return newSource(opts.api, opts.apiKey, logging.New(os.Stderr, "error", "text"))
+}
+
+func runDepartures(ctx context.Context, opts options, positional []string) error {
+	if len(positional) == 0 {
+		return errUsage
+	}
+	station := strings.Join(positional, " ")
+
+	src, err := opts.source()
+	if err != nil {
+		return err
+	}
+	out := opts.printer()
+	filter := domains.DepartureFilter{Lines: opts.lines, Destination: opts.destination, Limit: opts.limit}
+
+	for {
+		departures, err := src.GetDeparturesForMatchingStation(ctx, station)
+		if err != nil && !opts.watch {
+			return err
+		}
+
+		switch {
+		case opts.json && err == nil:
+			if err := out.json(filter.Apply(departures)); err != nil {
+				return err
+			}
+		case opts.json:
+			// JSON consumers of --watch read one document per refresh, errors go to stderr
+			fmt.Fprintln(os.Stderr, "kvb:", err)
+		default:
+			// --no-color only drops the colors, a terminal is still cleared between refreshes
+			if opts.watch && isTerminal(os.Stdout) {
+				out.clear()
+			}
+			if err != nil {
+				fmt.Fprintln(out.w, "kvb:", err)
+			} else {
+				out.departures(filter.Apply(departures))
+			}
+		}
+
+		if !opts.watch {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.interval):
+		}
+	}
+}
+
+func runStations(ctx context.Context, opts options) error {
+	src, err := opts.source()
+	if err != nil {
+		return err
+	}
+
+	stations, err := src.GetStations(ctx)
+	if err != nil {
+		return err
+	}
+	if opts.json {
+		return opts.printer().json(stations)
+	}
+	opts.printer().stations(stations)
+	return nil
+}
+
+func runSearch(ctx context.Context, opts options, positional []string) error {
+	if len(positional) == 0 {
+		return errUsage
+	}
+	src, err := opts.source()
+	if err != nil {
+		return err
+	}
+
+	stations, err := src.SearchStations(ctx, strings.Join(positional, " "), opts.limit)
+	if err != nil {
+		return err
+	}
+	if len(stations) == 0 {
+		return errors.New("no station found")
+	}
+	if opts.json {
+		return opts.printer().json(stations)
+	}
+	opts.printer().stations(stations)
+	return nil
+}
+
+// listFlag collects repeated and comma separated values
+type listFlag []string
+
+func (list *listFlag) String() string {
+	return strings.Join(*list, ",")
+}
+
+func (list *listFlag) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*list = append(*list, item)
+		}
+	}
+	return nil
+}
+
+func getEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,453 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/janritter/kvb-api/domains"
+)
+
+const (
+	// minPanelWidth is the width below which fewer stations are shown side by side
+	minPanelWidth = 36
+	// fetchTimeout bounds a single refresh of a station
+	fetchTimeout = 10 * time.Second
+	// pickerResults is the number of candidates shown by the station picker
+	pickerResults = 10
+)
+
+var (
+	titleStyle         = lipgloss.NewStyle().Bold(true)
+	panelStyle         = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240")).Padding(0, 1)
+	selectedPanelStyle = panelStyle.Copy().BorderForeground(lipgloss.Color("#E3001B"))
+	dimStyle           = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	errorStyle         = lipgloss.NewStyle().Foreground(lipgloss.Color("#E3001B"))
+	cursorStyle        = lipgloss.NewStyle().Reverse(true)
+)
+
+// monitor is the full-screen departure monitor of the favourite stations, countdowns tick locally between refreshes
+type monitor struct {
+	ctx            context.Context
+	src            source
+	interval       time.Duration
+	favouritesPath string
+
+	stations []string
+	boards   map[string]stationBoard
+	selected int
+	width    int
+	height   int
+	now      time.Time
+	status   string
+
+	// picker is set while a station is picked
+	picker *stationPicker
+}
+
+type stationBoard struct {
+	departures domains.Departures
+	err        error
+	loading    bool
+}
+
+type stationPicker struct {
+	query      string
+	candidates []domains.Station
+	cursor     int
+	err        error
+}
+
+type tickMsg time.Time
+
+type refreshMsg struct{}
+
+type departuresMsg struct {
+	station    string
+	departures domains.Departures
+	err        error
+}
+
+type candidatesMsg struct {
+	query      string
+	candidates []domains.Station
+	err        error
+}
+
+func runMonitor(ctx context.Context, opts options) error {
+	if !isTerminal(os.Stdout) {
+		return errors.New("the monitor needs a terminal")
+	}
+
+	path, err := favouritesPath()
+	if err != nil {
+		return err
+	}
+	stations, err := loadFavourites(path)
+	if err != nil {
+		return err
+	}
+	src, err := opts.source()
+	if err != nil {
+		return err
+	}
+
+	m := &monitor{
+		ctx:            ctx,
+		src:            src,
+		interval:       opts.interval,
+		favouritesPath: path,
+		stations:       stations,
+		boards:         map[string]stationBoard{},
+		now:            time.Now(),
+	}
+	// Without favourites there is nothing to show, the picker is opened right away
+	if len(stations) == 0 {
+		m.picker = &stationPicker{}
+	}
+
+	_, err = tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+	if errors.Is(err, tea.ErrProgramKilled) {
+		return nil
+	}
+	return err
+}
+
+func (m *monitor) Init() tea.Cmd {
+	return tea.Batch(tick(), m.scheduleRefresh(), m.fetchAll())
+}
+
+func tick() tea.Cmd {
+	return tea.Every(time.Second, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+func (m *monitor) scheduleRefresh() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return refreshMsg{}
+	})
+}
+
+func (m *monitor) fetchAll() tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(m.stations))
+	for _, station := range m.stations {
+		cmds = append(cmds, m.fetch(station))
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *monitor) fetch(station string) tea.Cmd {
+	board := m.boards[station]
+	board.loading = true
+	m.boards[station] = board
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, fetchTimeout)
+		defer cancel()
+
+		departures, err := m.src.GetDeparturesForMatchingStation(ctx, station)
+		return departuresMsg{station: station, departures: departures, err: err}
+	}
+}
+
+func (m *monitor) search(query string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, fetchTimeout)
+		defer cancel()
+
+		candidates, err := m.src.SearchStations(ctx, query, pickerResults)
+		return candidatesMsg{query: query, candidates: candidates, err: err}
+	}
+}
+
+func (m *monitor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+
+	case tickMsg:
+		m.now = time.Time(msg)
+		return m, tick()
+
+	case refreshMsg:
+		return m, tea.Batch(m.fetchAll(), m.scheduleRefresh())
+
+	case departuresMsg:
+		board := m.boards[msg.station]
+		board.loading = false
+		board.err = msg.err
+		// Failed refreshes keep counting down the last departures
+		if msg.err == nil {
+			board.departures = msg.departures
+		}
+		m.boards[msg.station] = board
+
+	case candidatesMsg:
+		// Results of outdated queries are dropped
+		if m.picker != nil && msg.query == m.picker.query {
+			m.picker.candidates = msg.candidates
+			m.picker.err = msg.err
+			m.picker.cursor = 0
+		}
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		if m.picker != nil {
+			return m, m.updatePicker(msg)
+		}
+		return m, m.updateMonitor(msg)
+	}
+
+	return m, nil
+}
+
+func (m *monitor) updateMonitor(msg tea.KeyMsg) tea.Cmd {
+	m.status = ""
+
+	switch msg.String() {
+	case "q", "esc":
+		return tea.Quit
+	case "left", "h":
+		if m.selected > 0 {
+			m.selected--
+		}
+	case "right", "l":
+		if m.selected < len(m.stations)-1 {
+			m.selected++
+		}
+	case "<":
+		if m.selected > 0 {
+			m.stations[m.selected-1], m.stations[m.selected] = m.stations[m.selected], m.stations[m.selected-1]
+			m.selected--
+			m.save()
+		}
+	case ">":
+		if m.selected < len(m.stations)-1 {
+			m.stations[m.selected+1], m.stations[m.selected] = m.stations[m.selected], m.stations[m.selected+1]
+			m.selected++
+			m.save()
+		}
+	case "a", "/":
+		m.picker = &stationPicker{}
+	case "d", "x":
+		if len(m.stations) > 0 {
+			delete(m.boards, m.stations[m.selected])
+			m.stations = append(m.stations[:m.selected], m.stations[m.selected+1:]...)
+			if m.selected >= len(m.stations) && m.selected > 0 {
+				m.selected--
+			}
+			m.save()
+		}
+	case "r":
+		return m.fetchAll()
+	}
+	return nil
+}
+
+func (m *monitor) updatePicker(msg tea.KeyMsg) tea.Cmd {
+	picker := m.picker
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.picker = nil
+		return nil
+	case tea.KeyUp:
+		if picker.cursor > 0 {
+			picker.cursor--
+		}
+		return nil
+	case tea.KeyDown:
+		if picker.cursor < len(picker.candidates)-1 {
+			picker.cursor++
+		}
+		return nil
+	case tea.KeyEnter:
+		if len(picker.candidates) == 0 {
+			return nil
+		}
+		m.picker = nil
+		// Names only select KVB stations, stations of other providers are added by their provider-qualified ID
+		candidate := picker.candidates[picker.cursor]
+		if candidate.ID == 0 {
+			return m.add(candidate.Ref)
+		}
+		return m.add(candidate.Name)
+	case tea.KeyBackspace:
+		if picker.query == "" {
+			return nil
+		}
+		runes := []rune(picker.query)
+		picker.query = string(runes[:len(runes)-1])
+	case tea.KeyRunes, tea.KeySpace:
+		picker.query += string(msg.Runes)
+	default:
+		return nil
+	}
+
+	if strings.TrimSpace(picker.query) == "" {
+		picker.candidates = nil
+		return nil
+	}
+	return m.search(picker.query)
+}
+
+// add adds the station to the favourites and selects it
+func (m *monitor) add(station string) tea.Cmd {
+	for i, favourite := range m.stations {
+		if favourite == station {
+			m.selected = i
+			return nil
+		}
+	}
+
+	m.stations = append(m.stations, station)
+	m.selected = len(m.stations) - 1
+	m.save()
+	return m.fetch(station)
+}
+
+func (m *monitor) save() {
+	if err := saveFavourites(m.favouritesPath, m.stations); err != nil {
+		m.status = err.Error()
+	}
+}
+
+func (m *monitor) View() string {
+	if m.width == 0 {
+		return ""
+	}
+	if m.picker != nil {
+		return m.viewPicker()
+	}
+
+	help := dimStyle.Render("←/→ select  a add  d remove  </> move  r refresh  q quit")
+	if m.status != "" {
+		help = errorStyle.Render(m.status)
+	}
+	if len(m.stations) == 0 {
+		return "No favourite stations, press a to add one\n\n" + help
+	}
+
+	// As many panels as fit are shown, scrolled so the selected one is visible
+	visible := m.width / minPanelWidth
+	if visible < 1 {
+		visible = 1
+	}
+	if visible > len(m.stations) {
+		visible = len(m.stations)
+	}
+	first := 0
+	if m.selected >= visible {
+		first = m.selected - visible + 1
+	}
+
+	panelWidth := m.width / visible
+	// Border, padding, title and the blank line below it
+	rows := m.height - 6
+	panels := make([]string, 0, visible)
+	for i := first; i < first+visible; i++ {
+		panels = append(panels, m.viewPanel(m.stations[i], i == m.selected, panelWidth, rows))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, panels...) + "\n" + help
+}
+
+func (m *monitor) viewPanel(station string, selected bool, width int, rows int) string {
+	style := panelStyle
+	if selected {
+		style = selectedPanelStyle
+	}
+	// The style width includes the padding but not the border
+	contentWidth := width - 4
+
+	board := m.boards[station]
+	title := station
+	if board.departures.Station != "" {
+		title = board.departures.Station
+	}
+
+	var lines []string
+	lines = append(lines, titleStyle.Render(truncate(title, contentWidth)), "")
+
+	// Stale responses arrive already aged, Aged moves FetchedAt along so the countdowns aren't reduced twice
+	departures := board.departures.Aged(m.now).Departures
+	destinationWidth := contentWidth - 5 - 2 - 7 - 1
+	for i, departure := range departures {
+		if i >= rows-1 {
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s %-*s %7s", lineBadge(departure), destinationWidth, truncate(departure.Destination, destinationWidth), countdown(departure)))
+	}
+	if len(departures) == 0 && !board.departures.FetchedAt.IsZero() {
+		lines = append(lines, dimStyle.Render("No departures"))
+	}
+
+	for len(lines) < rows+1 {
+		lines = append(lines, "")
+	}
+
+	switch {
+	case board.err != nil:
+		lines = append(lines, errorStyle.Render(truncate(board.err.Error(), contentWidth)))
+	case board.loading && board.departures.FetchedAt.IsZero():
+		lines = append(lines, dimStyle.Render("Loading…"))
+	case !board.departures.FetchedAt.IsZero():
+		age := m.now.Sub(board.departures.FetchedAt).Truncate(time.Second)
+		if age < 0 {
+			age = 0
+		}
+		lines = append(lines, dimStyle.Render(fmt.Sprintf("Updated %s ago", age)))
+	default:
+		lines = append(lines, "")
+	}
+
+	return style.Width(width - 2).Render(strings.Join(lines, "\n"))
+}
+
+func (m *monitor) viewPicker() string {
+	picker := m.picker
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Add station") + "\n\n")
+	b.WriteString("> " + picker.query + cursorStyle.Render(" ") + "\n\n")
+
+	switch {
+	case picker.err != nil:
+		b.WriteString(errorStyle.Render(picker.err.Error()) + "\n")
+	case strings.TrimSpace(picker.query) != "" && len(picker.candidates) == 0:
+		b.WriteString(dimStyle.Render("No station found") + "\n")
+	}
+	for i, candidate := range picker.candidates {
+		line := "  " + candidate.Name
+		if i == picker.cursor {
+			line = cursorStyle.Render("> " + candidate.Name)
+		}
+		if candidate.ID == 0 {
+			line += dimStyle.Render("  " + candidate.Ref)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("\n" + dimStyle.Render("type to search  ↑/↓ select  enter add  esc cancel"))
+	return b.String()
+}
+
+// lineBadge renders the line in its colors like on the network map
+func lineBadge(departure domains.Departure) string {
+	label := fmt.Sprintf(" %-3s ", departure.Line)
+	if departure.LineDetails == nil {
+		return label
+	}
+	return lipgloss.NewStyle().
+		Background(lipgloss.Color(departure.LineDetails.Color)).
+		Foreground(lipgloss.Color(departure.LineDetails.TextColor)).
+		Render(label)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+const (
+	// maxDestinationWidth truncates long destinations so rows fit narrow terminals
+	maxDestinationWidth = 32
+	ansiReset           = "\033[0m"
+	ansiDim             = "\033[2m"
+	ansiClearScreen     = "\033[H\033[2J"
+)
+
+type printer struct {
+	w     io.Writer
+	color bool
+}
+
+func (p printer) json(v any) error {
+	encoder := json.NewEncoder(p.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (p printer) departures(departures domains.Departures) {
+	header := departures.Station + "  " + departures.FetchedAt.In(domains.Location).Format("15:04")
+	if departures.Stale {
+		header += "  (stale)"
+	}
+	fmt.Fprintln(p.w, header)
+
+	if len(departures.Departures) == 0 {
+		fmt.Fprintln(p.w, p.dim("No departures"))
+		return
+	}
+
+	width := 0
+	for _, departure := range departures.Departures {
+		if n := utf8.RuneCountInString(departure.Destination); n > width {
+			width = n
+		}
+	}
+	if width > maxDestinationWidth {
+		width = maxDestinationWidth
+	}
+
+	for _, departure := range departures.Departures {
+		fmt.Fprintf(p.w, "%s  %-*s  %7s\n", p.badge(departure), width, truncate(departure.Destination, width), countdown(departure))
+	}
+}
+
+func (p printer) stations(stations []domains.Station) {
+	for _, station := range stations {
+		id := station.Ref
+		if station.ID != 0 {
+			id = strconv.Itoa(station.ID)
+		}
+		fmt.Fprintf(p.w, "%5s  %s\n", id, station.Name)
+	}
+}
+
+// badge renders the line in its colors like on the network map, lines without colors are shown plain
+func (p printer) badge(departure domains.Departure) string {
+	label := fmt.Sprintf(" %-3s ", departure.Line)
+	if !p.color || departure.LineDetails == nil {
+		return label
+	}
+
+	background, ok := ansiColor(departure.LineDetails.Color, 48)
+	if !ok {
+		return label
+	}
+	foreground, ok := ansiColor(departure.LineDetails.TextColor, 38)
+	if !ok {
+		foreground = "\033[38;2;255;255;255m"
+	}
+	return background + foreground + label + ansiReset
+}
+
+func (p printer) dim(text string) string {
+	if !p.color {
+		return text
+	}
+	return ansiDim + text + ansiReset
+}
+
+func (p printer) clear() {
+	fmt.Fprint(p.w, ansiClearScreen)
+}
+
+// ansiColor returns the 24-bit color escape sequence of a #RRGGBB color, layer is 38 for text and 48 for background
+func ansiColor(hex string, layer int) (string, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return "", false
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("\033[%d;2;%d;%d;%dm", layer, value>>16, (value>>8)&0xff, value&0xff), true
+}
+
+func countdown(departure domains.Departure) string {
+	switch {
+	case !departure.ArrivalKnown():
+		return "?"
+	case departure.ArrivalInMinutes == 0:
+		return "now"
+	}
+	return strconv.Itoa(departure.ArrivalInMinutes) + " min"
+}
+
+func truncate(text string, width int) string {
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:width-1]) + "…"
+}
+
+// isTerminal reports whether the file is a terminal, colors and clearing the screen are left out otherwise
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/adapters"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/services"
+)
+
+// source provides departures and stations either from a running API or in-process from KVB
+type source interface {
+	GetDeparturesForMatchingStation(ctx context.Context, station string) (domains.Departures, error)
+	GetStations(ctx context.Context) ([]domains.Station, error)
+	SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error)
+}
+
+// newSource returns an API client if apiURL is set, otherwise the adapters requesting KVB directly
+func newSource(apiURL string, apiKey string, logger *slog.Logger) (source, error) {
+	if apiURL != "" {
+		return &apiSource{
+			baseURL: strings.TrimSuffix(apiURL, "/"),
+			apiKey:  apiKey,
+			client:  &http.Client{Timeout: 10 * time.Second},
+		}, nil
+	}
+
+	lineRegistryAdapter, err := adapters.NewLineRegistryAdapter()
+	if err != nil {
+		return nil, err
+	}
+	kvbAdapter := adapters.NewKVBAdapter(getEnv("KVB_BASE_URL", ""), nil, logger)
+	stationMapperAdapter := adapters.NewStationMapperAdapter()
+	providers := []ports.DepartureProvider{adapters.NewKVBProvider(kvbAdapter, stationMapperAdapter)}
+	return &localSource{
+		service: services.New(stationMapperAdapter, nil, lineRegistryAdapter, providers, logger),
+	}, nil
+}
+
+type localSource struct {
+	service interface {
+		ports.DepartureService
+		ports.StationService
+	}
+}
+
+func (local *localSource) GetDeparturesForMatchingStation(ctx context.Context, station string) (domains.Departures, error) {
+	return local.service.GetDeparturesForMatchingStation(ctx, station)
+}
+
+func (local *localSource) GetStations(ctx context.Context) ([]domains.Station, error) {
+	return local.service.GetStations(ctx)
+}
+
+func (local *localSource) SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error) {
+	return local.service.SearchStations(ctx, query, limit)
+}
+
+type apiSource struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+func (api *apiSource) GetDeparturesForMatchingStation(ctx context.Context, station string) (domains.Departures, error) {
+	// The API router doesn't decode escaped slashes, names like Dom/Hbf are requested by the ref of the matching station
+	if strings.Contains(station, "/") {
+		stations, err := api.SearchStations(ctx, station, 1)
+		if err != nil {
+			return domains.Departures{}, err
+		}
+		if len(stations) == 0 {
+			return domains.Departures{}, fmt.Errorf("%w: %s", domains.ErrStationNotFound, station)
+		}
+		station = stations[0].Ref
+	}
+
+	var departures domains.Departures
+	if err := api.get(ctx, "/v1/departures/stations/"+url.PathEscape(station), nil, &departures); err != nil {
+		return domains.Departures{}, err
+	}
+	return departures, nil
+}
+
+func (api *apiSource) GetStations(ctx context.Context) ([]domains.Station, error) {
+	var response struct {
+		Stations []domains.Station `json:"stations"`
+	}
+	err := api.get(ctx, "/v1/stations", nil, &response)
+	return response.Stations, err
+}
+
+func (api *apiSource) SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error) {
+	var response struct {
+		Stations []domains.Station `json:"stations"`
+	}
+	err := api.get(ctx, "/v1/stations", url.Values{"q": {query}, "limit": {strconv.Itoa(limit)}}, &response)
+	return response.Stations, err
+}
+
+func (api *apiSource) get(ctx context.Context, path string, query url.Values, v any) error {
+	target := api.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if api.apiKey != "" {
+		req.Header.Set("X-API-Key", api.apiKey)
+	}
+
+	res, err := api.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var apiErr apiError
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error != "" {
+			return fmt.Errorf("API responded with %d: %s", res.StatusCode, apiErr.Error)
+		}
+		return fmt.Errorf("API responded with %d", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
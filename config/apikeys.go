@@ -0,0 +1,81 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+type APIKey struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+	// RateLimit in requests per second and Burst fall back to the configured defaults if unset
+	RateLimit float64 `json:"rateLimit"`
+	Burst     int     `json:"burst"`
+}
+
+// LoadAPIKeys returns the API keys from the API_KEYS variable ("name:key,name:key") and the JSON file at API_KEYS_FILE.
+// No keys means authentication is disabled.
+func (cfg Config) LoadAPIKeys() ([]APIKey, error) {
+	keys := []APIKey{}
+
+	for _, entry := range strings.Split(cfg.APIKeys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, key, found := strings.Cut(entry, ":")
+		if !found || name == "" || key == "" {
+			return nil, errors.New("invalid API key entry, expected name:key")
+		}
+		keys = append(keys, APIKey{Name: name, Key: key})
+	}
+
+	if cfg.APIKeysFile != "" {
+		content, err := os.ReadFile(cfg.APIKeysFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading API keys file: %w", err)
+		}
+
+		fileKeys := []APIKey{}
+		if err := json.Unmarshal(content, &fileKeys); err != nil {
+			return nil, fmt.Errorf("parsing API keys file: %w", err)
+		}
+		keys = append(keys, fileKeys...)
+	}
+
+	// Names identify clients in logs, metrics and the shared rate limit buckets, so they must be unique as well
+	seenKeys := map[string]bool{}
+	seenNames := map[string]bool{}
+	for i := range keys {
+		if keys[i].Name == "" {
+			return nil, errors.New("API key without name")
+		}
+		if keys[i].Key == "" {
+			return nil, fmt.Errorf("API key %q has no key", keys[i].Name)
+		}
+		if seenKeys[keys[i].Key] {
+			return nil, fmt.Errorf("API key %q is configured more than once", keys[i].Name)
+		}
+		if seenNames[keys[i].Name] {
+			return nil, fmt.Errorf("API key name %q is used more than once", keys[i].Name)
+		}
+		seenKeys[keys[i].Key] = true
+		seenNames[keys[i].Name] = true
+
+		if keys[i].RateLimit <= 0 {
+			keys[i].RateLimit = cfg.APIRateLimit
+		}
+		if keys[i].Burst <= 0 {
+			keys[i].Burst = cfg.APIRateLimitBurst
+		}
+		if keys[i].RateLimit <= 0 || keys[i].Burst < 1 {
+			return nil, fmt.Errorf("API key %q needs a rate limit greater than 0 and a burst of at least 1", keys[i].Name)
+		}
+	}
+
+	return keys, nil
+}
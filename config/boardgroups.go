@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LoadBoardGroups parses BOARD_GROUPS ("lobby=Neumarkt|Heumarkt;office=Zülpicher Platz") into station lists by group name
+func (cfg Config) LoadBoardGroups() (map[string][]string, error) {
+	groups := map[string][]string{}
+
+	for _, entry := range strings.Split(cfg.BoardGroups, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, stationList, found := strings.Cut(entry, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid board group %q, expected name=station|station", entry)
+		}
+
+		stations := []string{}
+		for _, station := range strings.Split(stationList, "|") {
+			if station = strings.TrimSpace(station); station != "" {
+				stations = append(stations, station)
+			}
+		}
+		if len(stations) == 0 {
+			return nil, fmt.Errorf("board group %q has no stations", name)
+		}
+
+		groups[name] = stations
+	}
+
+	return groups, nil
+}
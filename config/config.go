@@ -0,0 +1,209 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+type Config struct {
+	ListenAddress string
+	// GRPCListenAddress enables the gRPC server if set
+	GRPCListenAddress string
+	EnableTracing     bool
+
+	LogLevel  string
+	LogFormat string
+
+	KVBBaseURL                 string
+	KVBRetryMaxAttempts        int
+	KVBRetryBaseDelay          time.Duration
+	KVBRetryMaxDelay           time.Duration
+	KVBRequestTimeout          time.Duration
+	KVBBreakerFailureThreshold int
+	KVBBreakerCooldown         time.Duration
+	KVBRecordDir               string
+	KVBReplayDir               string
+	KVBSource                  string
+	KVBStationSources          string
+
+	// EFABaseURL enables EFA as departure source of KVB stations if set
+	EFABaseURL   string
+	EFAFormat    string
+	EFAProviders string
+
+	CacheTTL                  time.Duration
+	CacheStaleWhileRevalidate time.Duration
+	CacheMaxStaleness         time.Duration
+
+	KVBRateLimitGlobal       float64
+	KVBRateLimitGlobalBurst  int
+	KVBRateLimitStation      float64
+	KVBRateLimitStationBurst int
+	KVBRateLimitMaxWait      time.Duration
+	RateLimitRedisAddress    string
+
+	APIKeys           string
+	APIKeysFile       string
+	APIRateLimit      float64
+	APIRateLimitBurst int
+
+	BoardGroups string
+
+	GTFSRealtimeStationIDs string
+	GTFSMappingFile        string
+
+	GTFSStaticFile     string
+	GTFSStaticAgencyID string
+
+	MQTTBrokerURL             string
+	MQTTClientID              string
+	MQTTUsername              string
+	MQTTPassword              string
+	MQTTQoS                   int
+	MQTTTopicPrefix           string
+	MQTTStations              string
+	MQTTPublishInterval       time.Duration
+	MQTTDiscovery             bool
+	MQTTDiscoveryPrefix       string
+	MQTTEmbeddedBrokerAddress string
+
+	WebhooksEnabled            bool
+	WebhookRulesFile           string
+	WebhookPollInterval        time.Duration
+	WebhookMaxAttempts         int
+	WebhookRetryBaseDelay      time.Duration
+	WebhookRetryMaxDelay       time.Duration
+	WebhookRequestTimeout      time.Duration
+	WebhookAllowPrivateTargets bool
+
+	HistoryFile                string
+	HistoryRetention           time.Duration
+	HistoryDownsampleAfter     time.Duration
+	HistoryDownsampleInterval  time.Duration
+	HistoryMaintenanceInterval time.Duration
+
+	GraphQLMaxDepth      int
+	GraphQLMaxComplexity int
+}
+
+// Load reads the configuration from the environment, falling back to defaults for unset variables
+func Load() Config {
+	return Config{
+		ListenAddress:     getEnv("LISTEN_ADDRESS", ":8080"),
+		GRPCListenAddress: getEnv("GRPC_LISTEN_ADDRESS", ""),
+		EnableTracing:     getEnvBool("ENABLE_TRACING", false),
+
+		LogLevel:  getEnv("LOG_LEVEL", "info"),
+		LogFormat: getEnv("LOG_FORMAT", "json"),
+
+		KVBBaseURL:                 getEnv("KVB_BASE_URL", "https://www.kvb.koeln"),
+		KVBRetryMaxAttempts:        getEnvInt("KVB_RETRY_MAX_ATTEMPTS", 3),
+		KVBRetryBaseDelay:          getEnvDuration("KVB_RETRY_BASE_DELAY", 200*time.Millisecond),
+		KVBRetryMaxDelay:           getEnvDuration("KVB_RETRY_MAX_DELAY", 2*time.Second),
+		KVBRequestTimeout:          getEnvDuration("KVB_REQUEST_TIMEOUT", 4*time.Second),
+		KVBBreakerFailureThreshold: getEnvInt("KVB_BREAKER_FAILURE_THRESHOLD", 5),
+		KVBBreakerCooldown:         getEnvDuration("KVB_BREAKER_COOLDOWN", 30*time.Second),
+		KVBRecordDir:               getEnv("KVB_RECORD_DIR", ""),
+		KVBReplayDir:               getEnv("KVB_REPLAY_DIR", ""),
+		KVBSource:                  getEnv("KVB_SOURCE", "html"),
+		KVBStationSources:          getEnv("KVB_STATION_SOURCES", ""),
+
+		EFABaseURL:   getEnv("EFA_BASE_URL", ""),
+		EFAFormat:    getEnv("EFA_FORMAT", "json"),
+		EFAProviders: getEnv("EFA_PROVIDERS", ""),
+
+		CacheTTL:                  getEnvDuration("CACHE_TTL", 30*time.Second),
+		CacheStaleWhileRevalidate: getEnvDuration("CACHE_STALE_WHILE_REVALIDATE", 0),
+		CacheMaxStaleness:         getEnvDuration("CACHE_MAX_STALENESS", 10*time.Minute),
+
+		KVBRateLimitGlobal:       getEnvFloat("KVB_RATE_LIMIT_GLOBAL", 5),
+		KVBRateLimitGlobalBurst:  getEnvInt("KVB_RATE_LIMIT_GLOBAL_BURST", 10),
+		KVBRateLimitStation:      getEnvFloat("KVB_RATE_LIMIT_STATION", 0.2),
+		KVBRateLimitStationBurst: getEnvInt("KVB_RATE_LIMIT_STATION_BURST", 2),
+		KVBRateLimitMaxWait:      getEnvDuration("KVB_RATE_LIMIT_MAX_WAIT", 500*time.Millisecond),
+		RateLimitRedisAddress:    getEnv("RATE_LIMIT_REDIS_ADDRESS", ""),
+
+		APIKeys:           getEnv("API_KEYS", ""),
+		APIKeysFile:       getEnv("API_KEYS_FILE", ""),
+		APIRateLimit:      getEnvFloat("API_RATE_LIMIT", 1),
+		APIRateLimitBurst: getEnvInt("API_RATE_LIMIT_BURST", 30),
+
+		BoardGroups: getEnv("BOARD_GROUPS", ""),
+
+		GTFSRealtimeStationIDs: getEnv("GTFS_RT_STATION_IDS", ""),
+		GTFSMappingFile:        getEnv("GTFS_MAPPING_FILE", ""),
+
+		GTFSStaticFile:     getEnv("GTFS_STATIC_FILE", ""),
+		GTFSStaticAgencyID: getEnv("GTFS_STATIC_AGENCY_ID", ""),
+
+		MQTTBrokerURL:             getEnv("MQTT_BROKER_URL", ""),
+		MQTTClientID:              getEnv("MQTT_CLIENT_ID", "kvb-api"),
+		MQTTUsername:              getEnv("MQTT_USERNAME", ""),
+		MQTTPassword:              getEnv("MQTT_PASSWORD", ""),
+		MQTTQoS:                   getEnvInt("MQTT_QOS", 0),
+		MQTTTopicPrefix:           getEnv("MQTT_TOPIC_PREFIX", "kvb"),
+		MQTTStations:              getEnv("MQTT_STATIONS", ""),
+		MQTTPublishInterval:       getEnvDuration("MQTT_PUBLISH_INTERVAL", time.Minute),
+		MQTTDiscovery:             getEnvBool("MQTT_HOME_ASSISTANT_DISCOVERY", true),
+		MQTTDiscoveryPrefix:       getEnv("MQTT_DISCOVERY_PREFIX", "homeassistant"),
+		MQTTEmbeddedBrokerAddress: getEnv("MQTT_EMBEDDED_BROKER_ADDRESS", ""),
+
+		WebhooksEnabled:            getEnvBool("WEBHOOKS_ENABLED", false),
+		WebhookRulesFile:           getEnv("WEBHOOK_RULES_FILE", ""),
+		WebhookPollInterval:        getEnvDuration("WEBHOOK_POLL_INTERVAL", 30*time.Second),
+		WebhookMaxAttempts:         getEnvInt("WEBHOOK_MAX_ATTEMPTS", 5),
+		WebhookRetryBaseDelay:      getEnvDuration("WEBHOOK_RETRY_BASE_DELAY", time.Second),
+		WebhookRetryMaxDelay:       getEnvDuration("WEBHOOK_RETRY_MAX_DELAY", time.Minute),
+		WebhookRequestTimeout:      getEnvDuration("WEBHOOK_REQUEST_TIMEOUT", 5*time.Second),
+		WebhookAllowPrivateTargets: getEnvBool("WEBHOOK_ALLOW_PRIVATE_TARGETS", false),
+
+		HistoryFile:                getEnv("HISTORY_FILE", ""),
+		HistoryRetention:           getEnvDuration("HISTORY_RETENTION", 7*24*time.Hour),
+		HistoryDownsampleAfter:     getEnvDuration("HISTORY_DOWNSAMPLE_AFTER", 24*time.Hour),
+		HistoryDownsampleInterval:  getEnvDuration("HISTORY_DOWNSAMPLE_INTERVAL", 5*time.Minute),
+		HistoryMaintenanceInterval: getEnvDuration("HISTORY_MAINTENANCE_INTERVAL", time.Hour),
+
+		GraphQLMaxDepth:      getEnvInt("GRAPHQL_MAX_DEPTH", 10),
+		GraphQLMaxComplexity: getEnvInt("GRAPHQL_MAX_COMPLEXITY", 1000),
+	}
+}
+
+func getEnv(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	value, err := strconv.ParseBool(getEnv(key, strconv.FormatBool(fallback)))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(getEnv(key, strconv.Itoa(fallback)))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvFloat(key string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(getEnv(key, strconv.FormatFloat(fallback, 'f', -1, 64)), 64)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, fallback.String()))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
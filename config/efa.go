@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// LoadKVBSources parses KVB_SOURCE and the per station overrides of KVB_STATION_SOURCES ("2=efa,251=html")
+func (cfg Config) LoadKVBSources() (domains.DepartureSource, map[int]domains.DepartureSource, error) {
+	defaultSource, err := domains.ParseDepartureSource(cfg.KVBSource)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid KVB_SOURCE: %w", err)
+	}
+
+	stations := map[int]domains.DepartureSource{}
+	for _, entry := range strings.Split(cfg.KVBStationSources, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		value, sourceName, found := strings.Cut(entry, "=")
+		stationID, err := strconv.Atoi(strings.TrimSpace(value))
+		if !found || err != nil {
+			return "", nil, fmt.Errorf("invalid station source %q in KVB_STATION_SOURCES, expected station ID=source", entry)
+		}
+		source, err := domains.ParseDepartureSource(sourceName)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid station source %q in KVB_STATION_SOURCES: %w", entry, err)
+		}
+		stations[stationID] = source
+	}
+
+	return defaultSource, stations, nil
+}
+
+// LoadEFAProviders parses EFA_PROVIDERS ("swb=https://efa.example.org/swb;rvk=https://efa.example.org/rvk") into
+// base URLs by provider name
+func (cfg Config) LoadEFAProviders() (map[string]string, error) {
+	providers := map[string]string{}
+
+	for _, entry := range strings.Split(cfg.EFAProviders, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, baseURL, found := strings.Cut(entry, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		baseURL = strings.TrimSpace(baseURL)
+		if !found || name == "" || baseURL == "" || strings.Contains(name, ":") {
+			return nil, fmt.Errorf("invalid EFA provider %q, expected name=base URL", entry)
+		}
+		if name == domains.DefaultProvider {
+			return nil, fmt.Errorf("EFA provider must not be named %q, use EFA_BASE_URL for KVB stations", name)
+		}
+		if _, found := providers[name]; found {
+			return nil, fmt.Errorf("duplicate EFA provider %q", name)
+		}
+
+		providers[name] = baseURL
+	}
+
+	return providers, nil
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// LoadGTFSRealtimeStationIDs parses the comma separated KVB station IDs of GTFS_RT_STATION_IDS
+func (cfg Config) LoadGTFSRealtimeStationIDs() ([]int, error) {
+	stationIDs := []int{}
+	for _, value := range strings.Split(cfg.GTFSRealtimeStationIDs, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		stationID, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid station ID %q in GTFS_RT_STATION_IDS", value)
+		}
+		stationIDs = append(stationIDs, stationID)
+	}
+
+	return stationIDs, nil
+}
+
+// LoadGTFSMapping reads the JSON mapping file at GTFS_MAPPING_FILE, without file everything falls back to KVB IDs
+func (cfg Config) LoadGTFSMapping() (domains.GTFSMapping, error) {
+	mapping := domains.GTFSMapping{
+		Stops:  map[int]string{},
+		Routes: map[string]string{},
+	}
+	if cfg.GTFSMappingFile == "" {
+		return mapping, nil
+	}
+
+	content, err := os.ReadFile(cfg.GTFSMappingFile)
+	if err != nil {
+		return mapping, fmt.Errorf("reading GTFS mapping file: %w", err)
+	}
+	if err := json.Unmarshal(content, &mapping); err != nil {
+		return mapping, fmt.Errorf("parsing GTFS mapping file: %w", err)
+	}
+
+	return mapping, nil
+}
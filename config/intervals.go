@@ -0,0 +1,25 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValidateIntervals checks the intervals of the background jobs, tickers can't run with intervals of zero or less
+func (cfg Config) ValidateIntervals() error {
+	intervals := []struct {
+		name     string
+		interval time.Duration
+	}{
+		{"MQTT_PUBLISH_INTERVAL", cfg.MQTTPublishInterval},
+		{"WEBHOOK_POLL_INTERVAL", cfg.WebhookPollInterval},
+		{"HISTORY_MAINTENANCE_INTERVAL", cfg.HistoryMaintenanceInterval},
+	}
+
+	for _, interval := range intervals {
+		if interval.interval <= 0 {
+			return fmt.Errorf("invalid %s %s, must be greater than 0", interval.name, interval.interval)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LoadMQTTStations parses the comma separated station names of MQTT_STATIONS
+func (cfg Config) LoadMQTTStations() ([]string, error) {
+	stations := []string{}
+	for _, station := range strings.Split(cfg.MQTTStations, ",") {
+		station = strings.TrimSpace(station)
+		if station == "" {
+			continue
+		}
+		stations = append(stations, station)
+	}
+
+	if cfg.MQTTBrokerURL != "" && len(stations) == 0 {
+		return nil, fmt.Errorf("MQTT_BROKER_URL is set but MQTT_STATIONS is empty")
+	}
+	if cfg.MQTTQoS < 0 || cfg.MQTTQoS > 2 {
+		return nil, fmt.Errorf("invalid MQTT_QOS %d, must be 0, 1 or 2", cfg.MQTTQoS)
+	}
+
+	return stations, nil
+}
@@ -1,11 +1,85 @@
 package domains
 
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
 type Departures struct {
-	Departures []Departure `json:"departures"`
+	XMLName    xml.Name    `json:"-" xml:"departures"`
+	Station    string      `json:"station,omitempty" xml:"station,attr,omitempty"`
+	Departures []Departure `json:"departures" xml:"departure"`
+	Stale      bool        `json:"stale" xml:"stale,attr"`
+	FetchedAt  time.Time   `json:"fetchedAt" xml:"fetchedAt,attr"`
 }
 
+// UnknownArrival is the ArrivalInMinutes of departures whose countdown couldn't be read
+const UnknownArrival = -1
+
 type Departure struct {
-	Line             string `json:"line"`
-	Destination      string `json:"destination"`
-	ArrivalInMinutes int    `json:"arrivalInMinutes"`
+	Line             string `json:"line" xml:"line"`
+	Destination      string `json:"destination" xml:"destination"`
+	ArrivalInMinutes int    `json:"arrivalInMinutes" xml:"arrivalInMinutes"`
+	// LineDetails are nil for lines unknown to the line registry
+	LineDetails *Line `json:"lineDetails,omitempty" xml:"lineDetails,omitempty"`
+	// Platform, PlannedTime and DelayMinutes are only known for departures from EFA, DelayMinutes is nil without
+	// real-time data
+	Platform     string     `json:"platform,omitempty" xml:"platform,omitempty"`
+	PlannedTime  *time.Time `json:"plannedTime,omitempty" xml:"plannedTime,omitempty"`
+	DelayMinutes *int       `json:"delayMinutes,omitempty" xml:"delayMinutes,omitempty"`
+}
+
+// ArrivalKnown reports whether the countdown of the departure is known
+func (departure Departure) ArrivalKnown() bool {
+	return departure.ArrivalInMinutes != UnknownArrival
+}
+
+// ArrivalLabel returns the countdown in minutes as text, "?" if it is unknown
+func (departure Departure) ArrivalLabel() string {
+	if !departure.ArrivalKnown() {
+		return "?"
+	}
+	return strconv.Itoa(departure.ArrivalInMinutes)
+}
+
+// Table returns the departures as rows for CSV and plain text output
+func (departures Departures) Table() ([]string, [][]string) {
+	rows := make([][]string, 0, len(departures.Departures))
+	for _, departure := range departures.Departures {
+		rows = append(rows, []string{departure.Line, departure.Destination, departure.ArrivalLabel()})
+	}
+
+	return []string{"line", "destination", "arrivalInMinutes"}, rows
+}
+
+// Aged returns a stale copy of the departures as of now, with arrival times reduced by the elapsed minutes and departures in the past dropped.
+// Departures with unknown arrival are kept as they are.
+// FetchedAt moves forward by the same minutes, so FetchedAt plus a countdown stays the estimated departure time and
+// aging the copy again doesn't reduce the countdowns twice.
+func (departures Departures) Aged(now time.Time) Departures {
+	elapsed := int(now.Sub(departures.FetchedAt) / time.Minute)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	aged := make([]Departure, 0, len(departures.Departures))
+	for _, departure := range departures.Departures {
+		if !departure.ArrivalKnown() {
+			aged = append(aged, departure)
+			continue
+		}
+		departure.ArrivalInMinutes -= elapsed
+		if departure.ArrivalInMinutes < 0 {
+			continue
+		}
+		aged = append(aged, departure)
+	}
+
+	return Departures{
+		Station:    departures.Station,
+		Departures: aged,
+		Stale:      true,
+		FetchedAt:  departures.FetchedAt.Add(time.Duration(elapsed) * time.Minute),
+	}
 }
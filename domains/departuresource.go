@@ -0,0 +1,25 @@
+package domains
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DepartureSource is where departures of KVB stations are fetched from
+type DepartureSource string
+
+const (
+	// DepartureSourceHTML scrapes the departure pages of the KVB website
+	DepartureSourceHTML DepartureSource = "html"
+	// DepartureSourceEFA requests the EFA departure monitor
+	DepartureSourceEFA DepartureSource = "efa"
+)
+
+// ParseDepartureSource returns the source of the name
+func ParseDepartureSource(name string) (DepartureSource, error) {
+	switch source := DepartureSource(strings.ToLower(strings.TrimSpace(name))); source {
+	case DepartureSourceHTML, DepartureSourceEFA:
+		return source, nil
+	}
+	return "", fmt.Errorf("unknown departure source %q, supported are html and efa", name)
+}
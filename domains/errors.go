@@ -0,0 +1,38 @@
+package domains
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrCircuitOpen is returned when requests to the upstream are rejected because the circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// UpstreamStatusError is returned when the upstream answered with an unexpected HTTP status code
+type UpstreamStatusError struct {
+	StatusCode int
+}
+
+func (err *UpstreamStatusError) Error() string {
+	return fmt.Sprintf("upstream responded with status code %d", err.StatusCode)
+}
+
+// RateLimitedError is returned when a request was rejected by a rate limiter
+type RateLimitedError struct {
+	Scope      string
+	RetryAfter time.Duration
+}
+
+func (err *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limited (%s), retry after %s", err.Scope, err.RetryAfter)
+}
+
+// ErrStationNotFound is returned when no station exists for a given ID or matches a given name
+var ErrStationNotFound = errors.New("station not found")
+
+// ErrLineNotFound is returned when no line exists for a given name
+var ErrLineNotFound = errors.New("line not found")
+
+// ErrProviderNotFound is returned for station references of a provider which isn't enabled
+var ErrProviderNotFound = errors.New("provider not found")
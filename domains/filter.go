@@ -0,0 +1,50 @@
+package domains
+
+import "strings"
+
+// DepartureFilter selects departures by line and destination, zero values match everything
+type DepartureFilter struct {
+	Lines []string
+	// Destination matches case-insensitive substrings of the destination
+	Destination string
+	// Limit is the maximum number of departures kept, zero keeps all
+	Limit int
+}
+
+func (filter DepartureFilter) Matches(departure Departure) bool {
+	if len(filter.Lines) > 0 {
+		found := false
+		for _, line := range filter.Lines {
+			if strings.EqualFold(line, departure.Line) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if filter.Destination != "" && !strings.Contains(strings.ToLower(departure.Destination), strings.ToLower(filter.Destination)) {
+		return false
+	}
+
+	return true
+}
+
+// Apply returns a copy of the departures containing only the matching departures
+func (filter DepartureFilter) Apply(departures Departures) Departures {
+	filtered := departures
+	filtered.Departures = []Departure{}
+
+	for _, departure := range departures.Departures {
+		if filter.Limit > 0 && len(filtered.Departures) >= filter.Limit {
+			break
+		}
+		if filter.Matches(departure) {
+			filtered.Departures = append(filtered.Departures, departure)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,49 @@
+package domains
+
+import "strconv"
+
+// GTFSMapping links KVB station IDs and line names to GTFS stop_ids and route_ids
+type GTFSMapping struct {
+	Stops  map[int]string    `json:"stops"`
+	Routes map[string]string `json:"routes"`
+}
+
+// StopID returns the mapped GTFS stop_id, unmapped stations fall back to "kvb:<station ID>"
+func (mapping GTFSMapping) StopID(stationID int) string {
+	if stopID, found := mapping.Stops[stationID]; found {
+		return stopID
+	}
+	return "kvb:" + strconv.Itoa(stationID)
+}
+
+// RouteID returns the mapped GTFS route_id, unmapped lines fall back to the line name
+func (mapping GTFSMapping) RouteID(line string) string {
+	if routeID, found := mapping.Routes[line]; found {
+		return routeID
+	}
+	return line
+}
+
+// WithDefaults returns a copy of the mapping which falls back to the given stop_ids and route_ids for unmapped
+// stations and lines, entries of the mapping itself take precedence
+func (mapping GTFSMapping) WithDefaults(stops map[int]string, routes map[string]string) GTFSMapping {
+	merged := GTFSMapping{
+		Stops:  map[int]string{},
+		Routes: map[string]string{},
+	}
+
+	for stationID, stopID := range stops {
+		merged.Stops[stationID] = stopID
+	}
+	for stationID, stopID := range mapping.Stops {
+		merged.Stops[stationID] = stopID
+	}
+	for line, routeID := range routes {
+		merged.Routes[line] = routeID
+	}
+	for line, routeID := range mapping.Routes {
+		merged.Routes[line] = routeID
+	}
+
+	return merged
+}
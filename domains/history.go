@@ -0,0 +1,10 @@
+package domains
+
+import "time"
+
+// DepartureSnapshot is the departures of a station as fetched from KVB at one point in time
+type DepartureSnapshot struct {
+	StationID  int         `json:"stationId"`
+	FetchedAt  time.Time   `json:"fetchedAt"`
+	Departures []Departure `json:"departures"`
+}
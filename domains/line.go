@@ -0,0 +1,19 @@
+package domains
+
+type LineMode string
+
+const (
+	LineModeLightRail LineMode = "light-rail"
+	LineModeBus       LineMode = "bus"
+	LineModeNightBus  LineMode = "night-bus"
+)
+
+// Line describes a KVB line, colors are hex RGB values as used in KVB's network map
+type Line struct {
+	Name      string   `json:"name" xml:"name,attr"`
+	Mode      LineMode `json:"mode" xml:"mode,attr"`
+	Color     string   `json:"color" xml:"color,attr"`
+	TextColor string   `json:"textColor" xml:"textColor,attr"`
+	// Terminals are the end stations of the line, empty if they aren't known
+	Terminals []string `json:"terminals" xml:"terminal"`
+}
@@ -0,0 +1,18 @@
+package domains
+
+import (
+	"time"
+	// Embedded so the local time of Cologne is known in containers without zoneinfo
+	_ "time/tzdata"
+)
+
+// Location is the time zone of Cologne, times shown to users are in it
+var Location = mustLoadLocation("Europe/Berlin")
+
+func mustLoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return location
+}
@@ -0,0 +1,38 @@
+package domains
+
+import (
+	"strconv"
+	"strings"
+)
+
+// StationConcurrency is the number of stations a single request fetches at the same time, e.g. the stations of a board
+// or a batch, so one request can't flood the upstream
+const StationConcurrency = 4
+
+// DefaultProvider is the provider of the KVB station IDs used throughout the API
+const DefaultProvider = "kvb"
+
+// StationRef identifies a station of a departure provider, written provider-qualified as <provider>:<id>, e.g. kvb:2
+type StationRef struct {
+	Provider string
+	ID       string
+}
+
+// ParseStationRef parses a provider-qualified station ID, ok is false if the value has no provider prefix
+func ParseStationRef(value string) (StationRef, bool) {
+	provider, id, found := strings.Cut(value, ":")
+	if !found || provider == "" || id == "" {
+		return StationRef{}, false
+	}
+
+	return StationRef{Provider: strings.ToLower(provider), ID: id}, true
+}
+
+// KVBStationRef returns the reference of a KVB station ID
+func KVBStationRef(stationID int) StationRef {
+	return StationRef{Provider: DefaultProvider, ID: strconv.Itoa(stationID)}
+}
+
+func (ref StationRef) String() string {
+	return ref.Provider + ":" + ref.ID
+}
@@ -0,0 +1,44 @@
+package domains
+
+import "math"
+
+type Station struct {
+	// ID is the KVB station ID, zero for stations of other providers
+	ID int `json:"id,omitempty"`
+	// Ref is the provider-qualified station ID, e.g. kvb:2
+	Ref  string `json:"ref"`
+	Name string `json:"name"`
+	// StationDetails are only known for stations matched to a stop of the static GTFS feed
+	*StationDetails
+}
+
+// StationDetails are the properties of a station taken from the static GTFS feed
+type StationDetails struct {
+	StopID    string  `json:"stopId"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Routes are the short names of the routes serving the station, usually the KVB line names
+	Routes []string `json:"routes"`
+	// WheelchairAccessible is nil if the feed doesn't know whether the station is accessible
+	WheelchairAccessible *bool `json:"wheelchairAccessible,omitempty"`
+}
+
+// NearbyStation is a station within walking distance of another one
+type NearbyStation struct {
+	Station
+	// Distance is the beeline distance in meters
+	Distance float64 `json:"distance"`
+}
+
+// earthRadius is the mean earth radius in meters
+const earthRadius = 6371000
+
+// DistanceTo returns the beeline distance between the stations in meters, both need details with coordinates
+func (station Station) DistanceTo(other Station) float64 {
+	lat1, lat2 := station.Latitude*math.Pi/180, other.Latitude*math.Pi/180
+	deltaLat := lat2 - lat1
+	deltaLon := (other.Longitude - station.Longitude) * math.Pi / 180
+
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
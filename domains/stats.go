@@ -0,0 +1,45 @@
+package domains
+
+import "time"
+
+// PunctualityStats describes how the countdowns of departures evolved in the recorded snapshots
+type PunctualityStats struct {
+	StationID *int      `json:"stationId,omitempty"`
+	Station   string    `json:"station,omitempty"`
+	Line      string    `json:"line,omitempty"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	DepartureStats
+	// ByHour groups departures by the local hour of day of their first estimated departure time
+	ByHour []HourStats `json:"byHour"`
+	// ByWeekday groups departures by the local weekday of their first estimated departure time, starting with Monday
+	ByWeekday []WeekdayStats `json:"byWeekday"`
+}
+
+// DepartureStats aggregates tracked departures
+type DepartureStats struct {
+	Departures int `json:"departures"`
+	// Disappeared is the number of departures which vanished from the countdown before they were due
+	Disappeared       int     `json:"disappeared"`
+	DisappearanceRate float64 `json:"disappearanceRate"`
+	// DelayDrift holds percentiles of how many minutes the estimated departure time moved between the first and
+	// last sighting, positive values are delays
+	DelayDrift DriftPercentiles `json:"delayDriftMinutes"`
+}
+
+type DriftPercentiles struct {
+	P50 int `json:"p50"`
+	P90 int `json:"p90"`
+	P95 int `json:"p95"`
+	Max int `json:"max"`
+}
+
+type HourStats struct {
+	Hour int `json:"hour"`
+	DepartureStats
+}
+
+type WeekdayStats struct {
+	Weekday string `json:"weekday"`
+	DepartureStats
+}
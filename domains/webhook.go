@@ -0,0 +1,113 @@
+package domains
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"time"
+)
+
+var (
+	// ErrWebhookRuleNotFound is returned when no rule exists for an ID or the rule belongs to another client
+	ErrWebhookRuleNotFound = errors.New("webhook rule not found")
+	// ErrInvalidWebhookRule wraps the validation errors of webhook rules
+	ErrInvalidWebhookRule = errors.New("invalid webhook rule")
+	// ErrWebhookTargetNotAllowed is returned when a webhook URL resolves to an address which isn't public
+	ErrWebhookTargetNotAllowed = errors.New("webhook target address not allowed")
+)
+
+// MaxWebhookMinutesBefore limits how long before a departure an alert can be requested, KVB only reports the next hour
+const MaxWebhookMinutesBefore = 60
+
+// WebhookRule posts an alert to URL when a departure at Station matching Line and Destination is MinutesBefore minutes away
+type WebhookRule struct {
+	ID string `json:"id"`
+	// Owner is the API client which created the rule, empty if API keys are disabled
+	Owner       string `json:"owner,omitempty"`
+	Station     string `json:"station"`
+	Line        string `json:"line,omitempty"`
+	Destination string `json:"destination,omitempty"`
+	// MinutesBefore is the countdown at which the alert is sent
+	MinutesBefore int    `json:"minutesBefore"`
+	URL           string `json:"url"`
+	// Secret is the key of the HMAC signature of the payloads
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Filter returns the departure filter selecting the departures of the rule
+func (rule WebhookRule) Filter() DepartureFilter {
+	filter := DepartureFilter{Destination: rule.Destination}
+	if rule.Line != "" {
+		filter.Lines = []string{rule.Line}
+	}
+	return filter
+}
+
+func (rule WebhookRule) Validate() error {
+	if rule.Station == "" {
+		return fmt.Errorf("%w: station is required", ErrInvalidWebhookRule)
+	}
+	if rule.MinutesBefore < 0 || rule.MinutesBefore > MaxWebhookMinutesBefore {
+		return fmt.Errorf("%w: minutesBefore must be between 0 and %d", ErrInvalidWebhookRule, MaxWebhookMinutesBefore)
+	}
+
+	parsed, err := url.Parse(rule.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhookRule)
+	}
+	// Host names are resolved and checked by the webhook sender
+	if addr, err := netip.ParseAddr(parsed.Hostname()); err == nil && !IsPublicAddress(addr) {
+		return fmt.Errorf("%w: url must not point to a loopback, private or link-local address", ErrInvalidWebhookRule)
+	}
+
+	return nil
+}
+
+// nonPublicPrefixes are special purpose ranges not covered by the checks of netip.Addr
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// IsPublicAddress reports whether webhooks may be posted to the address. Loopback, private (RFC 1918 and unique local),
+// link-local including the 169.254.169.254 metadata endpoint of cloud providers and other special purpose addresses
+// aren't public.
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// WebhookDelivery is a departure alerted for a rule, departures are only known by line, destination and their
+// estimated departure time
+type WebhookDelivery struct {
+	Line          string    `json:"line"`
+	Destination   string    `json:"destination"`
+	DepartureTime time.Time `json:"departureTime"`
+}
+
+// WebhookAlert is the payload posted for a departure matching a rule
+type WebhookAlert struct {
+	RuleID           string    `json:"ruleId"`
+	Station          string    `json:"station"`
+	Line             string    `json:"line"`
+	Destination      string    `json:"destination"`
+	ArrivalInMinutes int       `json:"arrivalInMinutes"`
+	DepartureTime    time.Time `json:"departureTime"`
+	LineDetails      *Line     `json:"lineDetails,omitempty"`
+	// Stale is set if KVB was unavailable and the countdown was estimated from older departures
+	Stale bool `json:"stale"`
+}
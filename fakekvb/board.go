@@ -0,0 +1,162 @@
+package fakekvb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/janritter/kvb-api/domains"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	// boardHorizon is the number of minutes ahead departures are shown
+	boardHorizon = 60
+	// maxBoardRows is the number of departures shown at most
+	maxBoardRows = 20
+)
+
+type board struct {
+	Station string
+	Time    string
+	Rows    []boardRow
+}
+
+type boardRow struct {
+	Line        string
+	Destination string
+	Countdown   string
+	Minutes     int
+	// Truncated rows only have the line cell
+	Truncated bool
+}
+
+type generatedDeparture struct {
+	line        string
+	destination string
+	minutes     int
+}
+
+// The departures are the second table in the div, its first row is the header, like on kvb.koeln
+var boardTemplate = template.Must(template.New("board").Parse(`<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN">
+<html>
+<head>
+<meta http-equiv="Content-Type" content="text/html; charset=iso-8859-1">
+<title>KVB Abfahrtsmonitor - {{.Station}}</title>
+</head>
+<body>
+<div>
+<table class="qr_table">
+<tr><td class="qr_top_head_rot_small"><b>{{.Station}}</b></td><td class="qr_top_head_rot_small">{{.Time}}</td></tr>
+</table>
+<table class="display">
+<tr class="head"><td>Linie</td><td>Ziel</td><td>Abfahrt</td></tr>
+{{- range .Rows}}
+{{- if .Truncated}}
+<tr><td class="qr_td">{{.Line}}</td></tr>
+{{- else}}
+<tr><td class="qr_td">{{.Line}} </td><td>{{.Destination}}</td><td>{{.Countdown}}</td></tr>
+{{- end}}
+{{- end}}
+</table>
+</div>
+</body>
+</html>
+`))
+
+// board generates the departures of the station for the current time. Every station is served by a few lines chosen
+// by its ID, which run in both directions at a fixed headway, so successive boards count down consistently.
+func (server *Server) board(ctx context.Context, station domains.Station, scenario Scenario) (board, error) {
+	now := server.options.Now().In(domains.Location)
+	generated := board{
+		Station: station.Name,
+		Time:    now.Format("15:04"),
+	}
+	if scenario == ScenarioEmpty {
+		return generated, nil
+	}
+
+	lines, err := server.options.Lines.GetLines(ctx)
+	if err != nil {
+		return board{}, err
+	}
+
+	rng := rand.New(rand.NewSource(int64(station.ID)))
+	rng.Shuffle(len(lines), func(i, j int) {
+		lines[i], lines[j] = lines[j], lines[i]
+	})
+	if count := 2 + rng.Intn(3); count < len(lines) {
+		lines = lines[:count]
+	}
+
+	minuteOfDay := now.Hour()*60 + now.Minute()
+	var departures []generatedDeparture
+	for _, line := range lines {
+		headway := 10
+		if line.Mode != domains.LineModeLightRail {
+			headway = 20
+		}
+
+		for _, terminal := range line.Terminals {
+			offset := rng.Intn(headway)
+			// Departures don't go to the station they leave from
+			if strings.EqualFold(terminal, station.Name) {
+				continue
+			}
+			for minutes := ((offset-minuteOfDay)%headway + headway) % headway; minutes < boardHorizon; minutes += headway {
+				departures = append(departures, generatedDeparture{line: line.Name, destination: terminal, minutes: minutes})
+			}
+		}
+	}
+
+	sort.SliceStable(departures, func(i, j int) bool {
+		return departures[i].minutes < departures[j].minutes
+	})
+	if len(departures) > maxBoardRows {
+		departures = departures[:maxBoardRows]
+	}
+	if scenario == ScenarioSofort {
+		for i := 0; i < len(departures) && i < 2; i++ {
+			departures[i].minutes = 0
+		}
+	}
+
+	for i, departure := range departures {
+		row := boardRow{
+			Line:        departure.line,
+			Destination: departure.destination,
+			Countdown:   countdown(departure.minutes),
+			Minutes:     departure.minutes,
+		}
+		if scenario == ScenarioMalformed && i%3 == 2 {
+			row.Countdown = "k.A."
+		}
+		generated.Rows = append(generated.Rows, row)
+	}
+	if scenario == ScenarioMalformed && len(departures) > 0 {
+		generated.Rows = append(generated.Rows, boardRow{Line: departures[0].line, Truncated: true})
+	}
+
+	return generated, nil
+}
+
+func countdown(minutes int) string {
+	if minutes == 0 {
+		return "Sofort"
+	}
+	return fmt.Sprintf("%d Min", minutes)
+}
+
+// renderBoard renders the page in ISO-8859-1 like KVB
+func renderBoard(generated board) ([]byte, error) {
+	var page bytes.Buffer
+	if err := boardTemplate.Execute(&page, generated); err != nil {
+		return nil, err
+	}
+	return encoding.ReplaceUnsupported(charmap.ISO8859_1.NewEncoder()).Bytes(page.Bytes())
+}
@@ -0,0 +1,232 @@
+package fakekvb
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// efaDelay is the generated delay of a departure, every other departure runs a few minutes late
+func efaDelay(index int) int {
+	return index % 2 * (index % 5)
+}
+
+type efaJSONDateTime struct {
+	Year   string `json:"year"`
+	Month  string `json:"month"`
+	Day    string `json:"day"`
+	Hour   string `json:"hour"`
+	Minute string `json:"minute"`
+}
+
+type efaJSONDeparture struct {
+	StopName     string          `json:"stopName"`
+	Platform     string          `json:"platform"`
+	Countdown    string          `json:"countdown"`
+	DateTime     efaJSONDateTime `json:"dateTime"`
+	RealDateTime efaJSONDateTime `json:"realDateTime"`
+	ServingLine  struct {
+		Number    string `json:"number"`
+		Symbol    string `json:"symbol"`
+		Direction string `json:"direction"`
+		Delay     string `json:"delay"`
+		Realtime  string `json:"realtime"`
+	} `json:"servingLine"`
+}
+
+type efaXMLDateTime struct {
+	Date struct {
+		Year  int `xml:"year,attr"`
+		Month int `xml:"month,attr"`
+		Day   int `xml:"day,attr"`
+	} `xml:"itdDate"`
+	Time struct {
+		Hour   int `xml:"hour,attr"`
+		Minute int `xml:"minute,attr"`
+	} `xml:"itdTime"`
+}
+
+type efaXMLDeparture struct {
+	StopName    string         `xml:"stopName,attr"`
+	Platform    string         `xml:"platform,attr"`
+	Countdown   int            `xml:"countdown,attr"`
+	DateTime    efaXMLDateTime `xml:"itdDateTime"`
+	RTDateTime  efaXMLDateTime `xml:"itdRTDateTime"`
+	ServingLine struct {
+		Number    string `xml:"number,attr"`
+		Symbol    string `xml:"symbol,attr"`
+		Direction string `xml:"direction,attr"`
+		Realtime  int    `xml:"realtime,attr"`
+		NoTrain   struct {
+			Delay int `xml:"delay,attr"`
+		} `xml:"itdNoTrain"`
+	} `xml:"itdServingLine"`
+}
+
+type efaXMLPoint struct {
+	Name         string `xml:",chardata"`
+	AnyType      string `xml:"anyType,attr"`
+	StopID       string `xml:"stopID,attr"`
+	MatchQuality int    `xml:"matchQuality,attr"`
+}
+
+type efaXMLRequest struct {
+	XMLName    xml.Name          `xml:"itdRequest"`
+	Departures []efaXMLDeparture `xml:"itdDepartureMonitorRequest>itdDepartureList>itdDeparture,omitempty"`
+	Points     []efaXMLPoint     `xml:"itdStopFinderRequest>itdOdv>itdOdvName>odvNameElem,omitempty"`
+}
+
+// serveEFADepartures answers XML_DM_REQUEST with the normal board of the stop, scenarios only apply to the KVB pages
+// so the API's fallback between both can be tried
+func (server *Server) serveEFADepartures(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	stationID, err := strconv.Atoi(query.Get("name_dm"))
+	if err != nil {
+		server.writeEFA(w, query.Get("outputFormat"), map[string]any{"departureList": nil}, efaXMLRequest{})
+		return
+	}
+
+	station, err := server.options.Stations.GetStationForID(r.Context(), stationID)
+	if errors.Is(err, domains.ErrStationNotFound) {
+		// EFA answers unknown stops with an empty departure list
+		server.writeEFA(w, query.Get("outputFormat"), map[string]any{"departureList": nil}, efaXMLRequest{})
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	board, err := server.board(r.Context(), station, ScenarioNormal)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	now := server.options.Now().In(domains.Location).Truncate(time.Minute)
+	jsonDepartures := make([]efaJSONDeparture, 0, len(board.Rows))
+	xmlDepartures := make([]efaXMLDeparture, 0, len(board.Rows))
+	for i, row := range board.Rows {
+		delay := efaDelay(i)
+		realtime := now.Add(time.Duration(row.Minutes) * time.Minute)
+		planned := realtime.Add(-time.Duration(delay) * time.Minute)
+		platform := strconv.Itoa(i%2 + 1)
+
+		jsonDeparture := efaJSONDeparture{
+			StopName:     station.Name,
+			Platform:     platform,
+			Countdown:    strconv.Itoa(row.Minutes),
+			DateTime:     efaJSONTime(planned),
+			RealDateTime: efaJSONTime(realtime),
+		}
+		jsonDeparture.ServingLine.Number = row.Line
+		jsonDeparture.ServingLine.Symbol = row.Line
+		jsonDeparture.ServingLine.Direction = row.Destination
+		jsonDeparture.ServingLine.Delay = strconv.Itoa(delay)
+		jsonDeparture.ServingLine.Realtime = "1"
+		jsonDepartures = append(jsonDepartures, jsonDeparture)
+
+		xmlDeparture := efaXMLDeparture{
+			StopName:   station.Name,
+			Platform:   platform,
+			Countdown:  row.Minutes,
+			DateTime:   efaXMLTime(planned),
+			RTDateTime: efaXMLTime(realtime),
+		}
+		xmlDeparture.ServingLine.Number = row.Line
+		xmlDeparture.ServingLine.Symbol = row.Line
+		xmlDeparture.ServingLine.Direction = row.Destination
+		xmlDeparture.ServingLine.Realtime = 1
+		xmlDeparture.ServingLine.NoTrain.Delay = delay
+		xmlDepartures = append(xmlDepartures, xmlDeparture)
+	}
+
+	// Like EFA, a single departure is sent as object instead of a list
+	var departureList any = jsonDepartures
+	switch len(jsonDepartures) {
+	case 0:
+		departureList = nil
+	case 1:
+		departureList = map[string]any{"departure": jsonDepartures[0]}
+	}
+	server.writeEFA(w, query.Get("outputFormat"), map[string]any{"departureList": departureList}, efaXMLRequest{Departures: xmlDepartures})
+}
+
+// serveEFAStopFinder answers XML_STOPFINDER_REQUEST with the stations matching name_sf
+func (server *Server) serveEFAStopFinder(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	stations, err := server.options.Stations.SearchStations(r.Context(), query.Get("name_sf"), 10)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	points := make([]map[string]any, 0, len(stations))
+	xmlPoints := make([]efaXMLPoint, 0, len(stations))
+	for i, station := range stations {
+		stopID := strconv.Itoa(station.ID)
+		quality := 1000 - i
+		points = append(points, map[string]any{
+			"name":      station.Name,
+			"type":      "any",
+			"anyType":   "stop",
+			"stateless": stopID,
+			"quality":   strconv.Itoa(quality),
+			"ref":       map[string]string{"id": stopID},
+		})
+		xmlPoints = append(xmlPoints, efaXMLPoint{Name: station.Name, AnyType: "stop", StopID: stopID, MatchQuality: quality})
+	}
+
+	server.writeEFA(w, query.Get("outputFormat"), map[string]any{"stopFinder": map[string]any{"points": points}}, efaXMLRequest{Points: xmlPoints})
+}
+
+// writeEFA writes the JSON response in UTF-8 or the XML response in ISO-8859-1, which some EFA servers use
+func (server *Server) writeEFA(w http.ResponseWriter, outputFormat string, jsonResponse any, xmlResponse efaXMLRequest) {
+	if !strings.EqualFold(outputFormat, "xml") {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(jsonResponse)
+		return
+	}
+
+	body, err := xml.Marshal(xmlResponse)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	body, err = encoding.ReplaceUnsupported(charmap.ISO8859_1.NewEncoder()).Bytes(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml; charset=iso-8859-1")
+	w.Write([]byte(`<?xml version="1.0" encoding="ISO-8859-1"?>` + "\n"))
+	w.Write(body)
+}
+
+func efaJSONTime(t time.Time) efaJSONDateTime {
+	return efaJSONDateTime{
+		Year:   strconv.Itoa(t.Year()),
+		Month:  strconv.Itoa(int(t.Month())),
+		Day:    strconv.Itoa(t.Day()),
+		Hour:   strconv.Itoa(t.Hour()),
+		Minute: strconv.Itoa(t.Minute()),
+	}
+}
+
+func efaXMLTime(t time.Time) efaXMLDateTime {
+	var dateTime efaXMLDateTime
+	dateTime.Date.Year = t.Year()
+	dateTime.Date.Month = int(t.Month())
+	dateTime.Date.Day = t.Day()
+	dateTime.Time.Hour = t.Hour()
+	dateTime.Time.Minute = t.Minute()
+	return dateTime
+}
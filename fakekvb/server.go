@@ -0,0 +1,226 @@
+// Package fakekvb serves departure pages like kvb.koeln for local development and tests.
+// Server is an http.Handler, tests run it with httptest.NewServer and point the KVBAdapter at its URL.
+package fakekvb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/adapters"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+)
+
+// Scenario controls how a station's departure page is served
+type Scenario string
+
+const (
+	// ScenarioNormal serves a board of generated departures
+	ScenarioNormal Scenario = "normal"
+	// ScenarioEmpty serves a board without departures
+	ScenarioEmpty Scenario = "empty"
+	// ScenarioError answers with 500 Internal Server Error
+	ScenarioError Scenario = "error"
+	// ScenarioSlow serves the normal board after Options.SlowDelay
+	ScenarioSlow Scenario = "slow"
+	// ScenarioMalformed serves a board with rows missing cells and unparsable countdowns
+	ScenarioMalformed Scenario = "malformed"
+	// ScenarioSofort serves a board whose first departures leave immediately
+	ScenarioSofort Scenario = "sofort"
+)
+
+// ParseScenario returns the scenario of the name
+func ParseScenario(name string) (Scenario, error) {
+	switch scenario := Scenario(name); scenario {
+	case ScenarioNormal, ScenarioEmpty, ScenarioError, ScenarioSlow, ScenarioMalformed, ScenarioSofort:
+		return scenario, nil
+	}
+	return "", errors.New("unknown scenario " + strconv.Quote(name))
+}
+
+type Options struct {
+	// Stations resolves station codes to names, the station registry of the API is used if nil
+	Stations ports.StationMapperAdapter
+	// Lines are the lines departures are generated for, the line registry of the API is used if nil
+	Lines ports.LineRegistryAdapter
+	// Scenario is used for stations without a scenario of their own, ScenarioNormal if empty
+	Scenario Scenario
+	// SlowDelay is the delay of ScenarioSlow, 5s if zero
+	SlowDelay time.Duration
+	// Now returns the time boards are generated for, time.Now if nil
+	Now    func() time.Time
+	Logger *slog.Logger
+}
+
+// Server serves /generated/?aktion=show&code=N like KVB and changes scenarios on PUT /scenario?scenario=S[&station=N].
+// The same departures are served by an EFA departure monitor and stop finder below /efa, with KVB station IDs as stop IDs.
+type Server struct {
+	options Options
+	mux     *http.ServeMux
+
+	mu        sync.RWMutex
+	scenario  Scenario
+	scenarios map[int]Scenario
+}
+
+func NewServer(options Options) (*Server, error) {
+	if options.Stations == nil {
+		options.Stations = adapters.NewStationMapperAdapter()
+	}
+	if options.Lines == nil {
+		lines, err := adapters.NewLineRegistryAdapter()
+		if err != nil {
+			return nil, err
+		}
+		options.Lines = lines
+	}
+	if options.Scenario == "" {
+		options.Scenario = ScenarioNormal
+	}
+	if options.SlowDelay == 0 {
+		options.SlowDelay = 5 * time.Second
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	if options.Logger == nil {
+		options.Logger = slog.Default()
+	}
+
+	server := &Server{
+		options:   options,
+		mux:       http.NewServeMux(),
+		scenario:  options.Scenario,
+		scenarios: map[int]Scenario{},
+	}
+	server.mux.HandleFunc("/generated/", server.serveDepartures)
+	server.mux.HandleFunc("/scenario", server.serveScenario)
+	server.mux.HandleFunc("/efa/XML_DM_REQUEST", server.serveEFADepartures)
+	server.mux.HandleFunc("/efa/XML_STOPFINDER_REQUEST", server.serveEFAStopFinder)
+
+	return server, nil
+}
+
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mux.ServeHTTP(w, r)
+}
+
+// SetScenario changes the scenario of a station
+func (server *Server) SetScenario(stationID int, scenario Scenario) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.scenarios[stationID] = scenario
+}
+
+// SetDefaultScenario changes the scenario of all stations and drops the scenarios of single stations
+func (server *Server) SetDefaultScenario(scenario Scenario) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.scenario = scenario
+	server.scenarios = map[int]Scenario{}
+}
+
+func (server *Server) scenarioFor(stationID int) Scenario {
+	server.mu.RLock()
+	defer server.mu.RUnlock()
+
+	if scenario, found := server.scenarios[stationID]; found {
+		return scenario
+	}
+	return server.scenario
+}
+
+func (server *Server) serveDepartures(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	stationID, err := strconv.Atoi(query.Get("code"))
+	if query.Get("aktion") != "show" || err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	station, err := server.options.Stations.GetStationForID(r.Context(), stationID)
+	if errors.Is(err, domains.ErrStationNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	scenario := server.scenarioFor(stationID)
+	server.options.Logger.DebugContext(r.Context(), "Serving departures", slog.Int("stationID", stationID), slog.String("scenario", string(scenario)))
+
+	switch scenario {
+	case ScenarioError:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	case ScenarioSlow:
+		if !wait(r.Context(), server.options.SlowDelay) {
+			return
+		}
+	}
+
+	board, err := server.board(r.Context(), station, scenario)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	page, err := renderBoard(board)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=iso-8859-1")
+	w.Write(page)
+}
+
+func (server *Server) serveScenario(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		w.Header().Set("Allow", "PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	scenario, err := ParseScenario(query.Get("scenario"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if query.Get("station") == "" {
+		server.SetDefaultScenario(scenario)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	stationID, err := strconv.Atoi(query.Get("station"))
+	if err != nil {
+		http.Error(w, "station must be a number", http.StatusBadRequest)
+		return
+	}
+	server.SetScenario(stationID, scenario)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// wait returns false if the request was cancelled before the delay passed
+func wait(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,185 @@
+package fakekvb_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/janritter/kvb-api/adapters"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/fakekvb"
+)
+
+// neumarkt is a station with departures of several lines
+const neumarkt = 2
+
+var now = time.Date(2024, 3, 1, 8, 15, 0, 0, domains.Location)
+
+func newAdapter(t *testing.T, options fakekvb.Options) (*adapters.KVBAdapter, *fakekvb.Server) {
+	t.Helper()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	options.Now = func() time.Time { return now }
+	options.Logger = logger
+
+	server, err := fakekvb.NewServer(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	return adapters.NewKVBAdapter(httpServer.URL, nil, logger), server
+}
+
+func TestNormal(t *testing.T) {
+	adapter, _ := newAdapter(t, fakekvb.Options{})
+
+	departures, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(departures.Departures) == 0 {
+		t.Fatal("expected departures")
+	}
+
+	previous := 0
+	for _, departure := range departures.Departures {
+		if departure.Line == "" || departure.Destination == "" {
+			t.Errorf("incomplete departure %+v", departure)
+		}
+		if departure.ArrivalInMinutes < previous || departure.ArrivalInMinutes >= 60 {
+			t.Errorf("unexpected countdown %d after %d", departure.ArrivalInMinutes, previous)
+		}
+		previous = departure.ArrivalInMinutes
+		// The page is ISO-8859-1, umlauts have to survive decoding
+		if strings.ContainsRune(departure.Destination, '�') || strings.Contains(departure.Destination, "Ã") {
+			t.Errorf("destination %q wasn't decoded", departure.Destination)
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	adapter, _ := newAdapter(t, fakekvb.Options{Scenario: fakekvb.ScenarioEmpty})
+
+	departures, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(departures.Departures) != 0 {
+		t.Errorf("expected no departures, got %d", len(departures.Departures))
+	}
+}
+
+func TestError(t *testing.T) {
+	adapter, _ := newAdapter(t, fakekvb.Options{Scenario: fakekvb.ScenarioError})
+
+	_, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	var statusErr *domains.UpstreamStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected an upstream status error 500, got %v", err)
+	}
+}
+
+func TestUnknownStation(t *testing.T) {
+	adapter, _ := newAdapter(t, fakekvb.Options{})
+
+	_, err := adapter.GetDeparturesForStationID(context.Background(), 999999)
+	var statusErr *domains.UpstreamStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected an upstream status error 404, got %v", err)
+	}
+}
+
+func TestSlow(t *testing.T) {
+	adapter, _ := newAdapter(t, fakekvb.Options{Scenario: fakekvb.ScenarioSlow, SlowDelay: 200 * time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := adapter.GetDeparturesForStationID(ctx, neumarkt); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request to time out, got %v", err)
+	}
+
+	departures, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(departures.Departures) == 0 {
+		t.Error("expected departures once the delay passed")
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	adapter, server := newAdapter(t, fakekvb.Options{})
+	normal, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.SetDefaultScenario(fakekvb.ScenarioMalformed)
+	departures, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The truncated row is skipped, the rows with unparsable countdowns are kept
+	if len(departures.Departures) != len(normal.Departures) {
+		t.Fatalf("expected %d departures, got %d", len(normal.Departures), len(departures.Departures))
+	}
+	for i, departure := range departures.Departures {
+		if departure.Line == "" || departure.Destination == "" {
+			t.Errorf("expected row %d to have line and destination, got %+v", i, departure)
+		}
+		// Every third countdown is unparsable
+		if i%3 == 2 {
+			if departure.ArrivalKnown() {
+				t.Errorf("expected unparsable countdown of row %d to be unknown, got %d", i, departure.ArrivalInMinutes)
+			}
+			continue
+		}
+		if departure.ArrivalInMinutes != normal.Departures[i].ArrivalInMinutes {
+			t.Errorf("expected countdown %d of row %d, got %d", normal.Departures[i].ArrivalInMinutes, i, departure.ArrivalInMinutes)
+		}
+	}
+}
+
+func TestSofort(t *testing.T) {
+	adapter, _ := newAdapter(t, fakekvb.Options{Scenario: fakekvb.ScenarioSofort})
+
+	departures, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(departures.Departures) < 2 {
+		t.Fatalf("expected at least two departures, got %d", len(departures.Departures))
+	}
+	for _, departure := range departures.Departures[:2] {
+		if departure.ArrivalInMinutes != 0 {
+			t.Errorf("expected Sofort to be parsed as 0, got %d", departure.ArrivalInMinutes)
+		}
+	}
+}
+
+func TestScenarioPerStation(t *testing.T) {
+	adapter, server := newAdapter(t, fakekvb.Options{})
+	server.SetScenario(neumarkt, fakekvb.ScenarioError)
+
+	if _, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt); err == nil {
+		t.Error("expected the station's scenario to fail")
+	}
+
+	server.SetDefaultScenario(fakekvb.ScenarioEmpty)
+	departures, err := adapter.GetDeparturesForStationID(context.Background(), neumarkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(departures.Departures) != 0 {
+		t.Errorf("expected the default scenario to replace the station's, got %d departures", len(departures.Departures))
+	}
+}
@@ -0,0 +1,95 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/janritter/kvb-api/domains"
+	kvbv1 "github.com/janritter/kvb-api/proto/kvb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var lineModes = map[domains.LineMode]kvbv1.LineMode{
+	domains.LineModeLightRail: kvbv1.LineMode_LINE_MODE_LIGHT_RAIL,
+	domains.LineModeBus:       kvbv1.LineMode_LINE_MODE_BUS,
+	domains.LineModeNightBus:  kvbv1.LineMode_LINE_MODE_NIGHT_BUS,
+}
+
+func toDepartures(departures domains.Departures) *kvbv1.Departures {
+	message := &kvbv1.Departures{
+		Station:    departures.Station,
+		Departures: make([]*kvbv1.Departure, 0, len(departures.Departures)),
+		Stale:      departures.Stale,
+		FetchedAt:  timestamppb.New(departures.FetchedAt),
+	}
+	for _, departure := range departures.Departures {
+		converted := &kvbv1.Departure{
+			Line:             departure.Line,
+			Destination:      departure.Destination,
+			ArrivalInMinutes: int32(departure.ArrivalInMinutes),
+			LineDetails:      toLine(departure.LineDetails),
+			Platform:         departure.Platform,
+		}
+		if departure.PlannedTime != nil {
+			converted.PlannedTime = timestamppb.New(*departure.PlannedTime)
+		}
+		if departure.DelayMinutes != nil {
+			delay := int32(*departure.DelayMinutes)
+			converted.DelayMinutes = &delay
+		}
+		message.Departures = append(message.Departures, converted)
+	}
+	return message
+}
+
+func toLine(line *domains.Line) *kvbv1.Line {
+	if line == nil {
+		return nil
+	}
+
+	return &kvbv1.Line{
+		Name:      line.Name,
+		Mode:      lineModes[line.Mode],
+		Color:     line.Color,
+		TextColor: line.TextColor,
+		Terminals: line.Terminals,
+	}
+}
+
+func toStation(station domains.Station) *kvbv1.Station {
+	message := &kvbv1.Station{
+		Id:   int32(station.ID),
+		Name: station.Name,
+		Ref:  station.Ref,
+	}
+	if details := station.StationDetails; details != nil {
+		message.Details = &kvbv1.StationDetails{
+			StopId:               details.StopID,
+			Latitude:             details.Latitude,
+			Longitude:            details.Longitude,
+			Routes:               details.Routes,
+			WheelchairAccessible: details.WheelchairAccessible,
+		}
+	}
+	return message
+}
+
+// statusFromError maps the errors of the services to gRPC status codes
+func statusFromError(err error) error {
+	var upstreamStatusError *domains.UpstreamStatusError
+	var rateLimitedError *domains.RateLimitedError
+	switch {
+	case errors.Is(err, domains.ErrStationNotFound), errors.Is(err, domains.ErrProviderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domains.ErrCircuitOpen), errors.As(err, &upstreamStatusError):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.As(err, &rateLimitedError):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
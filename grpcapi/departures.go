@@ -0,0 +1,170 @@
+package grpcapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	kvbv1 "github.com/janritter/kvb-api/proto/kvb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBatchSize limits the stations of a BatchGetDepartures request
+	maxBatchSize = 50
+	// defaultWatchInterval is used by WatchDepartures requests without an interval
+	defaultWatchInterval = 30 * time.Second
+	// minWatchInterval protects the upstream from watchers polling too often
+	minWatchInterval = 10 * time.Second
+)
+
+// DepartureServer implements the gRPC DepartureService on top of the departure and station services
+type DepartureServer struct {
+	kvbv1.UnimplementedDepartureServiceServer
+
+	// shutdown ends the watch streams, which would hold up a graceful stop otherwise
+	shutdown         context.Context
+	departureService ports.DepartureService
+	stationService   ports.StationService
+}
+
+// NewDepartureServer creates the server, watch streams end with UNAVAILABLE once shutdown is done
+func NewDepartureServer(shutdown context.Context, departureService ports.DepartureService, stationService ports.StationService) *DepartureServer {
+	return &DepartureServer{
+		shutdown:         shutdown,
+		departureService: departureService,
+		stationService:   stationService,
+	}
+}
+
+func (server *DepartureServer) GetDepartures(ctx context.Context, request *kvbv1.GetDeparturesRequest) (*kvbv1.GetDeparturesResponse, error) {
+	departures, err := server.getDepartures(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &kvbv1.GetDeparturesResponse{Departures: departures}, nil
+}
+
+func (server *DepartureServer) BatchGetDepartures(ctx context.Context, request *kvbv1.BatchGetDeparturesRequest) (*kvbv1.BatchGetDeparturesResponse, error) {
+	if len(request.Requests) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d stations per batch", maxBatchSize)
+	}
+
+	results := make([]*kvbv1.BatchGetDeparturesResult, len(request.Requests))
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, domains.StationConcurrency)
+	for i, stationRequest := range request.Requests {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, stationRequest *kvbv1.GetDeparturesRequest) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			departures, err := server.getDepartures(ctx, stationRequest)
+			if err != nil {
+				results[i] = &kvbv1.BatchGetDeparturesResult{Result: &kvbv1.BatchGetDeparturesResult_Error{Error: status.Convert(err).Message()}}
+				return
+			}
+			results[i] = &kvbv1.BatchGetDeparturesResult{Result: &kvbv1.BatchGetDeparturesResult_Departures{Departures: departures}}
+		}(i, stationRequest)
+	}
+	wg.Wait()
+
+	return &kvbv1.BatchGetDeparturesResponse{Results: results}, nil
+}
+
+func (server *DepartureServer) SearchStations(ctx context.Context, request *kvbv1.SearchStationsRequest) (*kvbv1.SearchStationsResponse, error) {
+	if request.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	stations, err := server.stationService.SearchStations(ctx, request.Query, int(request.Limit))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &kvbv1.SearchStationsResponse{Stations: make([]*kvbv1.Station, 0, len(stations))}
+	for _, station := range stations {
+		response.Stations = append(response.Stations, toStation(station))
+	}
+	return response, nil
+}
+
+// WatchDepartures polls the departures every interval and sends them whenever they were fetched anew. Failed polls
+// are skipped, the stream only ends with the client, the server shutting down or if the first poll fails.
+func (server *DepartureServer) WatchDepartures(request *kvbv1.WatchDeparturesRequest, stream kvbv1.DepartureService_WatchDeparturesServer) error {
+	if request.Request == nil {
+		return status.Error(codes.InvalidArgument, "request is required")
+	}
+	interval := defaultWatchInterval
+	if request.Interval != nil {
+		interval = request.Interval.AsDuration()
+	}
+	if interval < minWatchInterval {
+		interval = minWatchInterval
+	}
+
+	ctx := stream.Context()
+	departures, err := server.getDepartures(ctx, request.Request)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&kvbv1.WatchDeparturesResponse{Departures: departures}); err != nil {
+		return err
+	}
+	lastFetchedAt := departures.FetchedAt.AsTime()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-server.shutdown.Done():
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-ticker.C:
+		}
+
+		departures, err := server.getDepartures(ctx, request.Request)
+		if err != nil || departures.FetchedAt.AsTime().Equal(lastFetchedAt) {
+			continue
+		}
+		if err := stream.Send(&kvbv1.WatchDeparturesResponse{Departures: departures}); err != nil {
+			return err
+		}
+		lastFetchedAt = departures.FetchedAt.AsTime()
+	}
+}
+
+func (server *DepartureServer) getDepartures(ctx context.Context, request *kvbv1.GetDeparturesRequest) (*kvbv1.Departures, error) {
+	var departures domains.Departures
+	var err error
+	switch station := request.Station.(type) {
+	case *kvbv1.GetDeparturesRequest_StationId:
+		departures, err = server.departureService.GetDeparturesForStationID(ctx, int(station.StationId))
+	case *kvbv1.GetDeparturesRequest_StationName:
+		departures, err = server.departureService.GetDeparturesForMatchingStation(ctx, station.StationName)
+	case *kvbv1.GetDeparturesRequest_StationRef:
+		ref, ok := domains.ParseStationRef(station.StationRef)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "station_ref must be <provider>:<id>")
+		}
+		departures, err = server.departureService.GetDeparturesForStation(ctx, ref)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "station_id, station_name or station_ref is required")
+	}
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	filter := domains.DepartureFilter{
+		Lines:       request.Lines,
+		Destination: request.Destination,
+		Limit:       int(request.Limit),
+	}
+	return toDepartures(filter.Apply(departures)), nil
+}
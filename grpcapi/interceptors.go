@@ -0,0 +1,126 @@
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/logging"
+	"github.com/janritter/kvb-api/metrics"
+	"github.com/janritter/kvb-api/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyMetadata is the metadata key holding the API key, the gRPC counterpart of the X-API-Key header
+const apiKeyMetadata = "x-api-key"
+
+// accessLogUnary logs one line per call like the access log of the HTTP API
+func accessLogUnary(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx, record := logging.WithAccessRecord(ctx)
+		resp, err := handler(ctx, req)
+		logCall(ctx, logger, info.FullMethod, record, err, start)
+		return resp, err
+	}
+}
+
+// accessLogStream logs one line per stream when it ends
+func accessLogStream(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx, record := logging.WithAccessRecord(stream.Context())
+		err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		logCall(ctx, logger, info.FullMethod, record, err, start)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, logger *slog.Logger, method string, record *logging.AccessRecord, err error, start time.Time) {
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", status.Code(err).String()),
+		slog.Duration("latency", time.Since(start)),
+	}
+	if client := record.Client(); client != "" {
+		attrs = append(attrs, slog.String("client", client))
+	}
+	if station, stationID := record.Station(); station != "" {
+		attrs = append(attrs, slog.String("station", station), slog.Int("station_id", stationID))
+	}
+	if cacheOutcome := record.CacheOutcome(); cacheOutcome != "" {
+		attrs = append(attrs, slog.String("cache", cacheOutcome))
+	}
+
+	logger.LogAttrs(ctx, slog.LevelInfo, "grpc call", attrs...)
+}
+
+// apiKeyAuth requires a valid API key in the x-api-key metadata and enforces the per-key rate limit, a stream counts
+// as a single request. Without configured keys all calls are let through. Health checks and reflection are public.
+type apiKeyAuth struct {
+	clients *services.APIClients
+	logger  *slog.Logger
+}
+
+func (auth *apiKeyAuth) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := auth.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (auth *apiKeyAuth) stream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := auth.authorize(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+func (auth *apiKeyAuth) authorize(ctx context.Context, method string) error {
+	if !auth.clients.Enabled() || strings.HasPrefix(method, "/grpc.health.") || strings.HasPrefix(method, "/grpc.reflection.") {
+		return nil
+	}
+
+	var key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(apiKeyMetadata); len(values) > 0 {
+			key = values[0]
+		}
+	}
+	client, found := auth.clients.Client(key)
+	if !found {
+		metrics.ClientRequests.WithLabelValues("unknown", "unauthorized").Inc()
+		return status.Error(codes.Unauthenticated, "missing or invalid API key")
+	}
+	logging.SetClient(ctx, client.Name)
+
+	reservation, err := client.Reserve(ctx)
+	if err != nil {
+		// Do not lock out clients because the limiter is unavailable
+		auth.logger.WarnContext(ctx, "Error reserving rate limit token, letting call through", slog.String("client", client.Name), slog.Any("error", err))
+		metrics.ClientRequests.WithLabelValues(client.Name, "allowed").Inc()
+		return nil
+	}
+	if !reservation.OK {
+		metrics.ClientRequests.WithLabelValues(client.Name, "rate_limited").Inc()
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %ds", int(math.Ceil(reservation.Wait.Seconds())))
+	}
+
+	metrics.ClientRequests.WithLabelValues(client.Name, "allowed").Inc()
+	return nil
+}
+
+// contextStream replaces the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *contextStream) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,63 @@
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	kvbv1 "github.com/janritter/kvb-api/proto/kvb/v1"
+	"github.com/janritter/kvb-api/services"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// healthPollInterval is how often the health status of the DepartureService follows the upstream
+const healthPollInterval = time.Second
+
+// NewServer creates the gRPC server serving the DepartureService along with the health checking and reflection
+// services. Calls are traced, logged and, like the HTTP API, authenticated with the API clients.
+// The DepartureService reports NOT_SERVING while upstreamAvailable returns false, the server itself stays SERVING
+// like /readyz stays ready. Health checking ends with ctx and reports NOT_SERVING from then on.
+func NewServer(ctx context.Context, departureServer kvbv1.DepartureServiceServer, clients *services.APIClients, upstreamAvailable func() bool, logger *slog.Logger) *grpc.Server {
+	auth := &apiKeyAuth{clients: clients, logger: logger}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), accessLogUnary(logger), auth.unary),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), accessLogStream(logger), auth.stream),
+	)
+
+	kvbv1.RegisterDepartureServiceServer(server, departureServer)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go followUpstream(ctx, healthServer, upstreamAvailable)
+
+	reflection.Register(server)
+
+	return server
+}
+
+// followUpstream updates the status of the DepartureService until ctx is done, watchers are only notified of changes
+func followUpstream(ctx context.Context, healthServer *health.Server, upstreamAvailable func() bool) {
+	ticker := time.NewTicker(healthPollInterval)
+	defer ticker.Stop()
+
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if !upstreamAvailable() {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus(kvbv1.DepartureService_ServiceDesc.ServiceName, status)
+
+		select {
+		case <-ctx.Done():
+			healthServer.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/logging"
+)
+
+// statusRecorder captures the status code written by the wrapped handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.ResponseWriter.Write(b)
+}
+
+// Flush passes flushes of inner middlewares like the compression through, so streamed responses reach the client
+func (rec *statusRecorder) Flush() {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	if flusher, ok := rec.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (rec *statusRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+// AccessLog logs one line per request with route, status, latency, resolved station and cache outcome
+func AccessLog(logger *slog.Logger) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			ctx, record := logging.WithAccessRecord(r.Context())
+			rec := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			if rec.status == 0 {
+				rec.status = http.StatusOK
+			}
+
+			route := r.URL.Path
+			if currentRoute := mux.CurrentRoute(r); currentRoute != nil {
+				if template, err := currentRoute.GetPathTemplate(); err == nil {
+					route = template
+				}
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", r.Method),
+				slog.String("route", route),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", rec.status),
+				slog.Duration("latency", time.Since(start)),
+			}
+			if client := record.Client(); client != "" {
+				attrs = append(attrs, slog.String("client", client))
+			}
+			if station, stationID := record.Station(); station != "" {
+				attrs = append(attrs, slog.String("station", station), slog.Int("station_id", stationID))
+			}
+			if cacheOutcome := record.CacheOutcome(); cacheOutcome != "" {
+				attrs = append(attrs, slog.String("cache", cacheOutcome))
+			}
+
+			logger.LogAttrs(ctx, slog.LevelInfo, "request", attrs...)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/config"
+	"github.com/janritter/kvb-api/logging"
+	"github.com/janritter/kvb-api/metrics"
+	"github.com/janritter/kvb-api/services"
+)
+
+const (
+	apiKeyHeader = "X-API-Key"
+	apiKeyQuery  = "api_key"
+)
+
+// clientContextKey holds the name of the authenticated API client in the request context
+type clientContextKey struct{}
+
+// clientName returns the name of the authenticated API client, empty if API keys are disabled
+func clientName(r *http.Request) string {
+	name, _ := r.Context().Value(clientContextKey{}).(string)
+	return name
+}
+
+// APIKeyAuth requires a valid API key in the X-API-Key header or api_key query parameter and enforces the per-key rate limit.
+// Without configured keys all requests are let through.
+func APIKeyAuth(clients *services.APIClients, logger *slog.Logger) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if !clients.Enabled() {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			// Responses depend on the key, caches must not answer requests of one key with those of another
+			w.Header().Add("Vary", apiKeyHeader)
+
+			key := r.Header.Get(apiKeyHeader)
+			if key == "" {
+				key = r.URL.Query().Get(apiKeyQuery)
+			}
+
+			client, found := clients.Client(key)
+			if !found {
+				metrics.ClientRequests.WithLabelValues("unknown", "unauthorized").Inc()
+				writeError(w, http.StatusUnauthorized, "missing or invalid API key")
+				return
+			}
+			logging.SetClient(ctx, client.Name)
+			ctx = context.WithValue(ctx, clientContextKey{}, client.Name)
+			r = r.WithContext(ctx)
+
+			reservation, err := client.Reserve(ctx)
+			if err != nil {
+				// Do not lock out clients because the limiter is unavailable
+				logger.WarnContext(ctx, "Error reserving rate limit token, letting request through", slog.String("client", client.Name), slog.Any("error", err))
+				metrics.ClientRequests.WithLabelValues(client.Name, "allowed").Inc()
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(client.Burst))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(reservation.Remaining))
+			w.Header().Set("X-RateLimit-Reset", strconv.Itoa(secondsUntilFull(client.APIKey, reservation.Remaining)))
+
+			if !reservation.OK {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(reservation.Wait.Seconds()))))
+				metrics.ClientRequests.WithLabelValues(client.Name, "rate_limited").Inc()
+				writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+				return
+			}
+
+			metrics.ClientRequests.WithLabelValues(client.Name, "allowed").Inc()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// secondsUntilFull returns the seconds until the bucket of key is refilled completely
+func secondsUntilFull(key config.APIKey, remaining int) int {
+	return int(math.Ceil(float64(key.Burst-remaining) / key.RateLimit))
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+)
+
+//go:embed templates/board.html
+var boardTemplates embed.FS
+
+var boardTemplate = template.Must(template.ParseFS(boardTemplates, "templates/board.html"))
+
+const (
+	defaultBoardRefresh = 30
+	minBoardRefresh     = 10
+	defaultBoardLimit   = 10
+	// maxBoardStations bounds comma separated station lists, configured groups may be longer
+	maxBoardStations = 12
+)
+
+type board struct {
+	Station    string
+	Departures []domains.Departure
+	Stale      bool
+	FetchedAt  time.Time
+	Error      bool
+}
+
+type boardPage struct {
+	Title   string
+	Refresh int
+	Now     time.Time
+	Boards  []board
+}
+
+// BoardHandler renders an HTML departure board for one or more stations or a configured station group
+type BoardHandler struct {
+	departureService ports.DepartureService
+	groups           map[string][]string
+	logger           *slog.Logger
+}
+
+func NewBoardHandler(departureService ports.DepartureService, groups map[string][]string, logger *slog.Logger) *BoardHandler {
+	return &BoardHandler{
+		departureService: departureService,
+		groups:           groups,
+		logger:           logger,
+	}
+}
+
+func (handler *BoardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := mux.Vars(r)["station"]
+
+	stations, isGroup := handler.groups[strings.ToLower(key)]
+	if !isGroup {
+		stations = []string{}
+		for _, station := range strings.Split(key, ",") {
+			if station = strings.TrimSpace(station); station != "" {
+				stations = append(stations, station)
+			}
+		}
+		if len(stations) > maxBoardStations {
+			writeError(w, http.StatusBadRequest, "a board shows at most "+strconv.Itoa(maxBoardStations)+" stations, configure a group for more")
+			return
+		}
+	}
+
+	filter := parseDepartureFilter(r, defaultBoardLimit)
+
+	refresh := defaultBoardRefresh
+	if value, err := strconv.Atoi(r.URL.Query().Get("refresh")); err == nil {
+		refresh = max(value, minBoardRefresh)
+	}
+
+	page := boardPage{
+		Title:   key,
+		Refresh: refresh,
+		Now:     time.Now().In(domains.Location),
+		Boards:  make([]board, len(stations)),
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, domains.StationConcurrency)
+	for i, station := range stations {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, station string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			page.Boards[i] = handler.board(r, station, filter)
+		}(i, station)
+	}
+	wg.Wait()
+
+	if !isGroup && len(page.Boards) == 1 && page.Boards[0].Station != "" {
+		page.Title = page.Boards[0].Station
+	}
+
+	buf := &bytes.Buffer{}
+	if err := boardTemplate.Execute(buf, page); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering board", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "error rendering board")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Write(buf.Bytes())
+}
+
+func (handler *BoardHandler) board(r *http.Request, station string, filter domains.DepartureFilter) board {
+	departures, err := handler.departureService.GetDeparturesForMatchingStation(r.Context(), station)
+	if err != nil {
+		return board{Station: station, Error: true}
+	}
+
+	departures = filter.Apply(departures)
+	name := departures.Station
+	if name == "" {
+		name = station
+	}
+
+	return board{
+		Station:    name,
+		Departures: departures.Departures,
+		Stale:      departures.Stale,
+		FetchedAt:  departures.FetchedAt.In(domains.Location),
+	}
+}
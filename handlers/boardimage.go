@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+// BoardImageFormat is an image format boards can be rendered in
+type BoardImageFormat struct {
+	name        string
+	contentType string
+	render      func(w io.Writer, departures domains.Departures, options renderers.BoardImageOptions) error
+}
+
+var (
+	BoardPNG = BoardImageFormat{name: "png", contentType: "image/png", render: renderers.RenderBoardPNG}
+	BoardSVG = BoardImageFormat{name: "svg", contentType: "image/svg+xml", render: renderers.RenderBoardSVG}
+)
+
+// BoardImageHandler renders the departures of a station as image for e-paper and smart displays
+type BoardImageHandler struct {
+	departureService ports.DepartureService
+	format           BoardImageFormat
+	cacheTTL         time.Duration
+	logger           *slog.Logger
+}
+
+func NewBoardImageHandler(departureService ports.DepartureService, format BoardImageFormat, cacheTTL time.Duration, logger *slog.Logger) *BoardImageHandler {
+	return &BoardImageHandler{
+		departureService: departureService,
+		format:           format,
+		cacheTTL:         cacheTTL,
+		logger:           logger,
+	}
+}
+
+func (handler *BoardImageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	options, err := parseBoardImageOptions(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Displays show the body of failed requests too, so errors are drawn as unavailable board with the error status
+	status := http.StatusOK
+	departures, err := handler.departureService.GetDeparturesForMatchingStation(r.Context(), mux.Vars(r)["key"])
+	if err != nil {
+		status, _ = setDepartureErrorHeaders(w, err)
+		departures = domains.Departures{Station: mux.Vars(r)["key"]}
+		options.Unavailable = true
+	} else {
+		departures = parseDepartureFilter(r, 0).Apply(departures)
+
+		// Every set of options renders another image
+		query := fnv.New32a()
+		query.Write([]byte(r.URL.RawQuery))
+		etag := departuresETag(departures, handler.format.name+"-"+strconv.FormatUint(uint64(query.Sum32()), 36))
+		setCacheHeaders(w, r, departures, etag, handler.cacheTTL)
+		if notModified(r, etag, departures.FetchedAt) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := handler.format.render(buf, departures, options); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering board image", slog.String("format", handler.format.name), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "error rendering board image")
+		return
+	}
+
+	w.Header().Set("Content-Type", handler.format.contentType)
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+func parseBoardImageOptions(r *http.Request) (renderers.BoardImageOptions, error) {
+	query := r.URL.Query()
+	options := renderers.BoardImageOptions{
+		Width:    800,
+		Height:   480,
+		Depth:    renderers.Color,
+		FontSize: 24,
+	}
+
+	intParams := []struct {
+		name     string
+		target   *int
+		min, max int
+	}{
+		{"width", &options.Width, 64, 2048},
+		{"height", &options.Height, 64, 2048},
+		{"fontSize", &options.FontSize, 8, 128},
+		{"rows", &options.Rows, 0, 50},
+	}
+	for _, param := range intParams {
+		value := query.Get(param.name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < param.min || parsed > param.max {
+			return options, fmt.Errorf("%s must be a number between %d and %d", param.name, param.min, param.max)
+		}
+		*param.target = parsed
+	}
+
+	if depth := query.Get("depth"); depth != "" {
+		switch renderers.ColorDepth(depth) {
+		case renderers.Monochrome, renderers.Grayscale, renderers.Color:
+			options.Depth = renderers.ColorDepth(depth)
+		default:
+			return options, fmt.Errorf("depth must be one of %s, %s or %s", renderers.Monochrome, renderers.Grayscale, renderers.Color)
+		}
+	}
+
+	return options, nil
+}
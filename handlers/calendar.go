@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+// CalendarHandler serves the upcoming departures of a station as iCalendar feed, filtered by the line and destination parameters
+type CalendarHandler struct {
+	departureService ports.DepartureService
+	cacheTTL         time.Duration
+	logger           *slog.Logger
+}
+
+func NewCalendarHandler(departureService ports.DepartureService, cacheTTL time.Duration, logger *slog.Logger) *CalendarHandler {
+	return &CalendarHandler{
+		departureService: departureService,
+		cacheTTL:         cacheTTL,
+		logger:           logger,
+	}
+}
+
+func (handler *CalendarHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	departures, err := handler.departureService.GetDeparturesForMatchingStation(r.Context(), mux.Vars(r)["key"])
+	if err != nil {
+		writeDepartureError(w, err)
+		return
+	}
+	departures = parseDepartureFilter(r, 0).Apply(departures)
+
+	etag := departuresETag(departures, "ics")
+	setCacheHeaders(w, r, departures, etag, handler.cacheTTL)
+	if notModified(r, etag, departures.FetchedAt) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	if err := renderers.RenderICS(buf, departures, max(handler.cacheTTL, time.Minute)); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering calendar", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "error rendering calendar")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+	w.Write(buf.Bytes())
+}
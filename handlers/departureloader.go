@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+)
+
+// departureLoader fetches the departures of each station at most once per request. Fetches start when a resolver asks
+// for them and run concurrently, so a query over N stations triggers at most N concurrent upstream fetches.
+type departureLoader struct {
+	ctx              context.Context
+	departureService ports.DepartureService
+
+	mu    sync.Mutex
+	calls map[domains.StationRef]*departureCall
+}
+
+type departureCall struct {
+	done       chan struct{}
+	departures domains.Departures
+	err        error
+}
+
+func newDepartureLoader(ctx context.Context, departureService ports.DepartureService) *departureLoader {
+	return &departureLoader{
+		ctx:              ctx,
+		departureService: departureService,
+		calls:            map[domains.StationRef]*departureCall{},
+	}
+}
+
+// load starts fetching the departures of the station unless already started and returns a function waiting for them
+func (loader *departureLoader) load(ref domains.StationRef) func() (domains.Departures, error) {
+	loader.mu.Lock()
+	call, found := loader.calls[ref]
+	if !found {
+		call = &departureCall{done: make(chan struct{})}
+		loader.calls[ref] = call
+
+		go func() {
+			defer close(call.done)
+			call.departures, call.err = loader.departureService.GetDeparturesForStation(loader.ctx, ref)
+		}()
+	}
+	loader.mu.Unlock()
+
+	return func() (domains.Departures, error) {
+		<-call.done
+		return call.departures, call.err
+	}
+}
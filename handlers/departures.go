@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+type DeparturesHandler struct {
+	departureService ports.DepartureService
+	renderers        *renderers.Renderers
+	cacheTTL         time.Duration
+	logger           *slog.Logger
+}
+
+// NewDeparturesHandler creates the departures handler, cacheTTL should match the upstream cache so clients don't cache longer than the API does
+func NewDeparturesHandler(departureService ports.DepartureService, renderers *renderers.Renderers, cacheTTL time.Duration, logger *slog.Logger) *DeparturesHandler {
+	return &DeparturesHandler{
+		departureService: departureService,
+		renderers:        renderers,
+		cacheTTL:         cacheTTL,
+		logger:           logger,
+	}
+}
+
+func (handler *DeparturesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	searchStation := vars["key"]
+
+	w.Header().Add("Vary", "Accept")
+	encoder, ok := handler.renderers.Negotiate(r)
+	if !ok {
+		writeError(w, http.StatusNotAcceptable, "unsupported format, supported are "+strings.Join(handler.renderers.Formats(), ", "))
+		return
+	}
+
+	departures, err := handler.departureService.GetDeparturesForMatchingStation(r.Context(), searchStation)
+	if err != nil {
+		writeDepartureError(w, err)
+		return
+	}
+
+	etag := departuresETag(departures, encoder.Format())
+	setCacheHeaders(w, r, departures, etag, handler.cacheTTL)
+	if notModified(r, etag, departures.FetchedAt) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if err := renderers.Write(w, encoder, http.StatusOK, departures); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering departures", slog.String("format", encoder.Format()), slog.Any("error", err))
+	}
+}
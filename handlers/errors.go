@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	renderers.WriteError(w, status, message)
+}
+
+// writeDepartureError answers a failed departure lookup with the status matching its cause, error responses must
+// not be cached by clients or CDNs
+func writeDepartureError(w http.ResponseWriter, err error) {
+	status, message := setDepartureErrorHeaders(w, err)
+	writeError(w, status, message)
+}
+
+// setDepartureErrorHeaders sets the headers of a failed departure lookup and returns the status and message matching its
+// cause, for handlers answering errors with another body than the JSON error
+func setDepartureErrorHeaders(w http.ResponseWriter, err error) (int, string) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	var statusErr *domains.UpstreamStatusError
+	var rateLimitedErr *domains.RateLimitedError
+	switch {
+	case errors.Is(err, domains.ErrStationNotFound), errors.Is(err, domains.ErrProviderNotFound):
+		return http.StatusNotFound, "station not found"
+	case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound:
+		return http.StatusNotFound, "station not found"
+	case errors.As(err, &rateLimitedErr):
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(rateLimitedErr.RetryAfter.Seconds()))))
+		return http.StatusTooManyRequests, "too many requests for this station, retry later"
+	case errors.Is(err, domains.ErrCircuitOpen):
+		return http.StatusServiceUnavailable, "departures are currently unavailable"
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, "departures took too long to load"
+	default:
+		return http.StatusBadGateway, "departures are currently unavailable"
+	}
+}
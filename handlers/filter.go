@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// parseDepartureFilter reads the line (repeatable or comma separated), destination and limit query parameters
+func parseDepartureFilter(r *http.Request, defaultLimit int) domains.DepartureFilter {
+	query := r.URL.Query()
+
+	filter := domains.DepartureFilter{
+		Destination: strings.TrimSpace(query.Get("destination")),
+		Limit:       defaultLimit,
+	}
+
+	for _, value := range query["line"] {
+		for _, line := range strings.Split(value, ",") {
+			if line = strings.TrimSpace(line); line != "" {
+				filter.Lines = append(filter.Lines, line)
+			}
+		}
+	}
+
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 {
+		filter.Limit = limit
+	}
+
+	return filter
+}
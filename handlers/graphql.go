@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+// maxGraphQLRequestSize limits the body of GraphQL requests
+const maxGraphQLRequestSize = 64 << 10
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// GraphQLHandler executes GraphQL queries over stations, lines and departures, sent as JSON body of a POST or as
+// query, variables and operationName parameters of a GET
+type GraphQLHandler struct {
+	schema           graphql.Schema
+	departureService ports.DepartureService
+	limits           GraphQLLimits
+	logger           *slog.Logger
+}
+
+func NewGraphQLHandler(departureService ports.DepartureService, stationService ports.StationService, lineService ports.LineService, limits GraphQLLimits, logger *slog.Logger) (*GraphQLHandler, error) {
+	schema, err := newGraphQLSchema(departureService, stationService, lineService)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GraphQLHandler{
+		schema:           schema,
+		departureService: departureService,
+		limits:           limits,
+		logger:           logger,
+	}, nil
+}
+
+func (handler *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request, ok := decodeGraphQLRequest(w, r)
+	if !ok {
+		return
+	}
+	if request.Query == "" {
+		writeError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	document, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"})})
+	if err != nil {
+		handler.write(w, r, http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+		return
+	}
+	if validation := graphql.ValidateDocument(&handler.schema, document, nil); !validation.IsValid {
+		handler.write(w, r, http.StatusBadRequest, &graphql.Result{Errors: validation.Errors})
+		return
+	}
+	if err := handler.limits.check(document, request.OperationName, request.Variables); err != nil {
+		handler.write(w, r, http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), departureLoaderContextKey{}, newDepartureLoader(r.Context(), handler.departureService))
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        handler.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       ctx,
+	})
+	handler.write(w, r, http.StatusOK, result)
+}
+
+func (handler *GraphQLHandler) write(w http.ResponseWriter, r *http.Request, status int, result *graphql.Result) {
+	if err := renderers.Write(w, renderers.JSON{}, status, result); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering GraphQL result", slog.Any("error", err))
+	}
+}
+
+func decodeGraphQLRequest(w http.ResponseWriter, r *http.Request) (graphQLRequest, bool) {
+	var request graphQLRequest
+	switch r.Method {
+	case http.MethodGet:
+		request.Query = r.URL.Query().Get("query")
+		request.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid variables: "+err.Error())
+				return request, false
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLRequestSize)).Decode(&request); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid GraphQL request: "+err.Error())
+			return request, false
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return request, false
+	}
+	return request, true
+}
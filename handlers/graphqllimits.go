@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// GraphQLLimits bound the cost of a GraphQL query before it is executed
+type GraphQLLimits struct {
+	// MaxDepth is the maximum nesting of fields, root fields have depth 1
+	MaxDepth int
+	// MaxComplexity is the maximum estimated cost, see queryCost
+	MaxComplexity int
+}
+
+// fieldCosts are the extra costs of fields calling the upstream. Fields are matched by name only, so the lines of the
+// query pay for the upstream call of the lines of a station too.
+var fieldCosts = map[string]int{
+	"departures": 10,
+	"lines":      10,
+}
+
+// defaultListSizes are the assumed sizes of list fields without a limit argument
+var defaultListSizes = map[string]int{
+	"stations":   100,
+	"lines":      20,
+	"departures": 20,
+	"nearby":     5,
+}
+
+// check returns an error if the operation of the document exceeds the limits
+func (limits GraphQLLimits) check(document *ast.Document, operationName string, variables map[string]interface{}) error {
+	fragments := map[string]*ast.FragmentDefinition{}
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operation = definition
+			}
+		}
+	}
+	if operation == nil {
+		// Execution reports the missing operation
+		return nil
+	}
+
+	cost := queryCost{fragments: fragments, variables: variables}
+	depth := cost.depth(operation.SelectionSet, map[string]bool{})
+	if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds the maximum of %d", depth, limits.MaxDepth)
+	}
+	complexity := cost.complexity(operation.SelectionSet, map[string]bool{})
+	if limits.MaxComplexity > 0 && complexity > limits.MaxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the maximum of %d", complexity, limits.MaxComplexity)
+	}
+	return nil
+}
+
+// queryCost estimates the cost of a query. Every field costs 1 plus its entry in fieldCosts, the cost of the fields
+// selected on a list is multiplied by its limit argument or its entry in defaultListSizes.
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+func (cost queryCost) depth(selectionSet *ast.SelectionSet, visiting map[string]bool) int {
+	depth := 0
+	cost.walk(selectionSet, visiting, func(field *ast.Field) {
+		depth = max(depth, 1+cost.depth(field.SelectionSet, visiting))
+	})
+	return depth
+}
+
+func (cost queryCost) complexity(selectionSet *ast.SelectionSet, visiting map[string]bool) int {
+	complexity := 0
+	cost.walk(selectionSet, visiting, func(field *ast.Field) {
+		complexity += 1 + fieldCosts[field.Name.Value] + cost.listSize(field)*cost.complexity(field.SelectionSet, visiting)
+	})
+	return complexity
+}
+
+// walk calls visit for each field of the selection set including those of fragments, skipping introspection fields.
+// Fragments already being walked are skipped, validation rejects fragment cycles anyway.
+func (cost queryCost) walk(selectionSet *ast.SelectionSet, visiting map[string]bool, visit func(field *ast.Field)) {
+	if selectionSet == nil {
+		return
+	}
+
+	for _, selection := range selectionSet.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if !strings.HasPrefix(selection.Name.Value, "__") {
+				visit(selection)
+			}
+		case *ast.InlineFragment:
+			cost.walk(selection.SelectionSet, visiting, visit)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			if fragment, found := cost.fragments[name]; found && !visiting[name] {
+				visiting[name] = true
+				cost.walk(fragment.SelectionSet, visiting, visit)
+				delete(visiting, name)
+			}
+		}
+	}
+}
+
+func (cost queryCost) listSize(field *ast.Field) int {
+	if field.SelectionSet == nil {
+		return 0
+	}
+
+	size, isList := defaultListSizes[field.Name.Value]
+	if !isList {
+		return 1
+	}
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "limit" {
+			continue
+		}
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if limit, err := strconv.Atoi(value.Value); err == nil && limit > 0 {
+				size = limit
+			}
+		case *ast.Variable:
+			switch limit := cost.variables[value.Name.Value].(type) {
+			case float64:
+				if limit > 0 {
+					size = int(limit)
+				}
+			case int:
+				if limit > 0 {
+					size = limit
+				}
+			}
+		}
+	}
+	return size
+}
@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/graphql-go/graphql"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+)
+
+type departureLoaderContextKey struct{}
+
+// stationLine is a line serving a station, its departures are those of the line at the station
+type stationLine struct {
+	station domains.Station
+	line    domains.Line
+}
+
+// newGraphQLSchema builds the schema over stations, lines and departures. Departures are fetched through the
+// departureLoader of the request context.
+func newGraphQLSchema(departureService ports.DepartureService, stationService ports.StationService, lineService ports.LineService) (graphql.Schema, error) {
+	lineType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Line",
+		Description: "A KVB line with its colors as used in the network map",
+		Fields: graphql.Fields{
+			"name":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"mode":      &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "light-rail, bus or night-bus"},
+			"color":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"textColor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"terminals": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+		},
+	})
+
+	departureType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Departure",
+		Fields: graphql.Fields{
+			"line":             &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"destination":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"arrivalInMinutes": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "Minutes until departure, -1 if the countdown is unknown"},
+			"lineDetails": &graphql.Field{
+				Type: lineType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if details := p.Source.(domains.Departure).LineDetails; details != nil {
+						return *details, nil
+					}
+					return nil, nil
+				},
+			},
+			"platform": &graphql.Field{
+				Type:        graphql.String,
+				Description: "Only known for departures from EFA",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if platform := p.Source.(domains.Departure).Platform; platform != "" {
+						return platform, nil
+					}
+					return nil, nil
+				},
+			},
+			"plannedTime": &graphql.Field{
+				Type:        graphql.DateTime,
+				Description: "Only known for departures from EFA",
+			},
+			"delayMinutes": &graphql.Field{
+				Type:        graphql.Int,
+				Description: "Only known for departures from EFA with real-time data",
+			},
+		},
+	})
+
+	stationLineType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "StationLine",
+		Description: "A line serving a station",
+		Fields: graphql.Fields{
+			"line": &graphql.Field{
+				Type: graphql.NewNonNull(lineType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(stationLine).line, nil
+				},
+			},
+			"departures": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(departureType))),
+				Description: "The next departures of the line at the station",
+				Args: graphql.FieldConfigArgument{
+					"limit": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 5},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					source := p.Source.(stationLine)
+					filter := domains.DepartureFilter{Lines: []string{source.line.Name}, Limit: p.Args["limit"].(int)}
+					return resolveDepartures(p, source.station, filter), nil
+				},
+			},
+		},
+	})
+
+	var stationType *graphql.Object
+	stationType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Station",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type:        graphql.Int,
+					Description: "KVB station ID, null for stations of other providers",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if id := p.Source.(domains.Station).ID; id != 0 {
+							return id, nil
+						}
+						return nil, nil
+					},
+				},
+				"ref":  &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "Provider-qualified station ID, e.g. kvb:2"},
+				"name": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"stopId": &graphql.Field{
+					Type:        graphql.String,
+					Description: "ID of the stop in the static GTFS feed",
+					Resolve: stationDetail(func(details *domains.StationDetails) interface{} {
+						return details.StopID
+					}),
+				},
+				"latitude": &graphql.Field{
+					Type: graphql.Float,
+					Resolve: stationDetail(func(details *domains.StationDetails) interface{} {
+						return details.Latitude
+					}),
+				},
+				"longitude": &graphql.Field{
+					Type: graphql.Float,
+					Resolve: stationDetail(func(details *domains.StationDetails) interface{} {
+						return details.Longitude
+					}),
+				},
+				"wheelchairAccessible": &graphql.Field{
+					Type: graphql.Boolean,
+					Resolve: stationDetail(func(details *domains.StationDetails) interface{} {
+						if details.WheelchairAccessible == nil {
+							return nil
+						}
+						return *details.WheelchairAccessible
+					}),
+				},
+				"lines": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(stationLineType))),
+					Description: "The lines serving the station according to the static GTFS feed and the current departures",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						station := p.Source.(domains.Station)
+						wait := loaderFromContext(p).load(stationRef(station))
+
+						return func() (interface{}, error) {
+							departures, err := wait()
+							if err != nil {
+								return nil, err
+							}
+							return stationLines(p, lineService, station, departures)
+						}, nil
+					},
+				},
+				"departures": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(departureType))),
+					Args: graphql.FieldConfigArgument{
+						"lines":       &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+						"destination": &graphql.ArgumentConfig{Type: graphql.String},
+						"limit":       &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 10},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						filter := domains.DepartureFilter{Limit: p.Args["limit"].(int)}
+						if lines, ok := p.Args["lines"].([]interface{}); ok {
+							for _, line := range lines {
+								filter.Lines = append(filter.Lines, line.(string))
+							}
+						}
+						if destination, ok := p.Args["destination"].(string); ok {
+							filter.Destination = destination
+						}
+						return resolveDepartures(p, p.Source.(domains.Station), filter), nil
+					},
+				},
+				"nearby": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(nearbyStationType(stationType)))),
+					Description: "Stations within radius meters, closest first, only known for KVB stations with a static GTFS feed",
+					Args: graphql.FieldConfigArgument{
+						"radius": &graphql.ArgumentConfig{Type: graphql.Float, DefaultValue: 500.0},
+						"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 5},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						station := p.Source.(domains.Station)
+						if station.ID == 0 {
+							return []domains.NearbyStation{}, nil
+						}
+						return stationService.GetNearbyStations(p.Context, station.ID, p.Args["radius"].(float64), p.Args["limit"].(int))
+					},
+				},
+			}
+		}),
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"station": &graphql.Field{
+				Type:        stationType,
+				Description: "A station by its KVB station ID or the station best matching the name among all providers",
+				Args: graphql.FieldConfigArgument{
+					"id":   &graphql.ArgumentConfig{Type: graphql.Int},
+					"name": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if id, ok := p.Args["id"].(int); ok {
+						station, err := stationService.GetStationForID(p.Context, id)
+						if errors.Is(err, domains.ErrStationNotFound) {
+							return nil, nil
+						}
+						return station, err
+					}
+					if name, ok := p.Args["name"].(string); ok {
+						stations, err := stationService.SearchStations(p.Context, name, 1)
+						if err != nil || len(stations) == 0 {
+							return nil, err
+						}
+						return stations[0], nil
+					}
+					return nil, errors.New("either id or name is required")
+				},
+			},
+			"stations": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(stationType))),
+				Description: "All KVB stations ordered by name, or the stations of all providers matching search best first",
+				Args: graphql.FieldConfigArgument{
+					"search": &graphql.ArgumentConfig{Type: graphql.String},
+					"limit":  &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limit, _ := p.Args["limit"].(int)
+					if search, ok := p.Args["search"].(string); ok && search != "" {
+						return stationService.SearchStations(p.Context, search, limit)
+					}
+
+					stations, err := stationService.GetStations(p.Context)
+					if limit > 0 && len(stations) > limit {
+						stations = stations[:limit]
+					}
+					return stations, err
+				},
+			},
+			"line": &graphql.Field{
+				Type: lineType,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					line, err := lineService.GetLine(p.Context, p.Args["name"].(string))
+					if errors.Is(err, domains.ErrLineNotFound) {
+						return nil, nil
+					}
+					return line, err
+				},
+			},
+			"lines": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(lineType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return lineService.GetLines(p.Context)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+func nearbyStationType(stationType *graphql.Object) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "NearbyStation",
+		Fields: graphql.Fields{
+			"station": &graphql.Field{
+				Type: graphql.NewNonNull(stationType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domains.NearbyStation).Station, nil
+				},
+			},
+			"distance": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: "Beeline distance in meters",
+			},
+		},
+	})
+}
+
+// stationDetail resolves a field of the static GTFS details of a station, null for stations without details
+func stationDetail(field func(details *domains.StationDetails) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		station := p.Source.(domains.Station)
+		if station.StationDetails == nil {
+			return nil, nil
+		}
+		return field(station.StationDetails), nil
+	}
+}
+
+// resolveDepartures returns a thunk of the filtered departures of the station, so the fetches of all stations of a
+// query run concurrently before the first one is waited for
+func resolveDepartures(p graphql.ResolveParams, station domains.Station, filter domains.DepartureFilter) func() (interface{}, error) {
+	wait := loaderFromContext(p).load(stationRef(station))
+
+	return func() (interface{}, error) {
+		departures, err := wait()
+		if err != nil {
+			return nil, err
+		}
+		return filter.Apply(departures).Departures, nil
+	}
+}
+
+// stationLines returns the routes of the static GTFS feed followed by the lines only seen in the departures
+func stationLines(p graphql.ResolveParams, lineService ports.LineService, station domains.Station, departures domains.Departures) ([]stationLine, error) {
+	var names []string
+	seen := map[string]bool{}
+	if station.StationDetails != nil {
+		for _, route := range station.Routes {
+			if !seen[route] {
+				seen[route] = true
+				names = append(names, route)
+			}
+		}
+	}
+	for _, departure := range departures.Departures {
+		if !seen[departure.Line] {
+			seen[departure.Line] = true
+			names = append(names, departure.Line)
+		}
+	}
+
+	lines := []stationLine{}
+	for _, name := range names {
+		// The line registry only knows KVB lines
+		if station.ID == 0 {
+			lines = append(lines, stationLine{station: station, line: domains.Line{Name: name}})
+			continue
+		}
+
+		line, err := lineService.GetLine(p.Context, name)
+		if errors.Is(err, domains.ErrLineNotFound) {
+			// Lines the registry can't derive are still listed, without colors
+			line = domains.Line{Name: name}
+		} else if err != nil {
+			return nil, err
+		}
+		lines = append(lines, stationLine{station: station, line: line})
+	}
+	return lines, nil
+}
+
+// stationRef returns the provider-qualified ID of the station
+func stationRef(station domains.Station) domains.StationRef {
+	if ref, ok := domains.ParseStationRef(station.Ref); ok {
+		return ref
+	}
+	return domains.KVBStationRef(station.ID)
+}
+
+func loaderFromContext(p graphql.ResolveParams) *departureLoader {
+	return p.Context.Value(departureLoaderContextKey{}).(*departureLoader)
+}
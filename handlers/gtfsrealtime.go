@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// GTFSRealtimeHandler serves the departures of the configured stations as GTFS-Realtime TripUpdates feed.
+// The feed is protobuf encoded, format=text returns the protobuf text format for debugging.
+type GTFSRealtimeHandler struct {
+	departureService ports.DepartureService
+	stationIDs       []int
+	mapping          domains.GTFSMapping
+	logger           *slog.Logger
+}
+
+func NewGTFSRealtimeHandler(departureService ports.DepartureService, stationIDs []int, mapping domains.GTFSMapping, logger *slog.Logger) *GTFSRealtimeHandler {
+	return &GTFSRealtimeHandler{
+		departureService: departureService,
+		stationIDs:       stationIDs,
+		mapping:          mapping,
+		logger:           logger,
+	}
+}
+
+func (handler *GTFSRealtimeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	departuresByStation := map[int]domains.Departures{}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, stationID := range handler.stationIDs {
+		wg.Add(1)
+		go func(stationID int) {
+			defer wg.Done()
+
+			// Stations failing to load are left out of the feed
+			departures, err := handler.departureService.GetDeparturesForStationID(r.Context(), stationID)
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			departuresByStation[stationID] = departures
+		}(stationID)
+	}
+	wg.Wait()
+
+	feed := renderers.BuildTripUpdates(departuresByStation, handler.mapping, time.Now())
+
+	if r.URL.Query().Get("format") == "text" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte(prototext.MarshalOptions{Multiline: true}.Format(feed)))
+		return
+	}
+
+	payload, err := proto.Marshal(feed)
+	if err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error marshalling GTFS-Realtime feed", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "error encoding feed")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(payload)
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type CheckStatus string
+
+const (
+	CheckOK       CheckStatus = "ok"
+	CheckDegraded CheckStatus = "degraded"
+	CheckFailing  CheckStatus = "failing"
+)
+
+// ReadinessCheck reports the status of a dependency, detail is included in the response as-is
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) (status CheckStatus, detail string)
+}
+
+type checkResult struct {
+	Status CheckStatus `json:"status"`
+	Detail string      `json:"detail,omitempty"`
+}
+
+type readinessResponse struct {
+	Status CheckStatus            `json:"status"`
+	Checks map[string]checkResult `json:"checks"`
+}
+
+// Healthz reports liveness of the process
+func Healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"status":"ok"}`))
+}
+
+// Readyz runs all readiness checks and responds with 503 if any of them is failing, degraded checks keep the instance ready
+func Readyz(checks ...ReadinessCheck) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response := readinessResponse{
+			Status: CheckOK,
+			Checks: map[string]checkResult{},
+		}
+
+		for _, check := range checks {
+			status, detail := check.Check(r.Context())
+			response.Checks[check.Name] = checkResult{Status: status, Detail: detail}
+
+			if status == CheckFailing || (status == CheckDegraded && response.Status == CheckOK) {
+				response.Status = status
+			}
+		}
+
+		payload, _ := json.Marshal(response)
+
+		w.Header().Set("Content-Type", "application/json")
+		if response.Status == CheckFailing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		w.Write(payload)
+	}
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+const (
+	// defaultHistoryWindow is returned when the request has no from parameter
+	defaultHistoryWindow = time.Hour
+	// maxHistoryWindow bounds the size of history responses
+	maxHistoryWindow = 24 * time.Hour
+)
+
+type historyResponse struct {
+	StationID int                         `json:"stationId"`
+	From      time.Time                   `json:"from"`
+	To        time.Time                   `json:"to"`
+	Snapshots []domains.DepartureSnapshot `json:"snapshots"`
+}
+
+// HistoryHandler returns the recorded departure snapshots of a station within from and to
+type HistoryHandler struct {
+	historyService ports.HistoryService
+	logger         *slog.Logger
+}
+
+func NewHistoryHandler(historyService ports.HistoryService, logger *slog.Logger) *HistoryHandler {
+	return &HistoryHandler{
+		historyService: historyService,
+		logger:         logger,
+	}
+}
+
+func (handler *HistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stationID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "station ID must be a number")
+		return
+	}
+
+	from, to, ok := parseTimeWindow(w, r, defaultHistoryWindow, maxHistoryWindow)
+	if !ok {
+		return
+	}
+
+	snapshots, err := handler.historyService.GetSnapshots(r.Context(), stationID, from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error loading history")
+		return
+	}
+
+	response := historyResponse{
+		StationID: stationID,
+		From:      from,
+		To:        to,
+		Snapshots: snapshots,
+	}
+	if err := renderers.Write(w, renderers.JSON{}, http.StatusOK, response); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering history", slog.Any("error", err))
+	}
+}
+
+// parseTimeWindow reads the from and to parameters, to defaults to now and from to defaultWindow before to.
+// It writes the error response and returns false if they are invalid or further than maxWindow apart.
+func parseTimeWindow(w http.ResponseWriter, r *http.Request, defaultWindow time.Duration, maxWindow time.Duration) (time.Time, time.Time, bool) {
+	to, err := parseTimeParameter(r, "to", time.Now())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "to must be an RFC3339 time")
+		return time.Time{}, time.Time{}, false
+	}
+	from, err := parseTimeParameter(r, "from", to.Add(-defaultWindow))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "from must be an RFC3339 time")
+		return time.Time{}, time.Time{}, false
+	}
+	if !from.Before(to) {
+		writeError(w, http.StatusBadRequest, "from must be before to")
+		return time.Time{}, time.Time{}, false
+	}
+	if to.Sub(from) > maxWindow {
+		writeError(w, http.StatusBadRequest, "from and to may be at most "+maxWindow.String()+" apart")
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// parseTimeParameter reads an RFC3339 query parameter, returning fallback if it is missing
+func parseTimeParameter(r *http.Request, name string, fallback time.Time) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return fallback, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
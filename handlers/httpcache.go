@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// departuresETag returns a weak ETag over the departures in the given format, the fetch time is left out so refetching an unchanged board keeps the ETag
+func departuresETag(departures domains.Departures, format string) string {
+	payload, _ := json.Marshal(struct {
+		Departures []domains.Departure
+		Stale      bool
+	}{departures.Departures, departures.Stale})
+
+	sum := sha256.Sum256(payload)
+	return `W/"` + hex.EncodeToString(sum[:16]) + "-" + format + `"`
+}
+
+// setCacheHeaders sets ETag, Last-Modified and a Cache-Control max-age of the time left until the departures expire from the upstream cache
+func setCacheHeaders(w http.ResponseWriter, r *http.Request, departures domains.Departures, etag string, ttl time.Duration) {
+	maxAge := 0
+	if !departures.Stale {
+		remaining := ttl - time.Since(departures.FetchedAt)
+		if remaining > 0 {
+			maxAge = int(remaining / time.Second)
+		}
+	}
+
+	w.Header().Set("ETag", etag)
+	setCacheControl(w, r, maxAge)
+	if !departures.FetchedAt.IsZero() {
+		w.Header().Set("Last-Modified", departures.FetchedAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+// setCacheControl lets clients cache the response for maxAge seconds, responses to authenticated API clients are private
+// so shared caches don't hand them to requests without a valid key
+func setCacheControl(w http.ResponseWriter, r *http.Request, maxAge int) {
+	scope := "public"
+	if clientName(r) != "" {
+		scope = "private"
+	}
+	w.Header().Set("Cache-Control", fmt.Sprintf("%s, max-age=%d", scope, maxAge))
+}
+
+// notModified evaluates If-None-Match and, if not present, If-Modified-Since
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince := r.Header.Get("If-Modified-Since"); ifModifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		if err == nil && !lastModified.Truncate(time.Second).After(since) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+// linesMaxAge lets clients cache lines, the registry is embedded and only changes with a release
+const linesMaxAge = 86400
+
+type linesResponse struct {
+	Lines []domains.Line `json:"lines"`
+}
+
+// LinesHandler lists the lines of the line registry
+type LinesHandler struct {
+	lineService ports.LineService
+	logger      *slog.Logger
+}
+
+func NewLinesHandler(lineService ports.LineService, logger *slog.Logger) *LinesHandler {
+	return &LinesHandler{
+		lineService: lineService,
+		logger:      logger,
+	}
+}
+
+func (handler *LinesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	lines, err := handler.lineService.GetLines(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error loading lines")
+		return
+	}
+
+	setCacheControl(w, r, linesMaxAge)
+	if err := renderers.Write(w, renderers.JSON{}, http.StatusOK, linesResponse{Lines: lines}); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering lines", slog.Any("error", err))
+	}
+}
+
+// LineHandler returns a single line by its name, lines missing in the registry are derived from their name
+type LineHandler struct {
+	lineService ports.LineService
+	logger      *slog.Logger
+}
+
+func NewLineHandler(lineService ports.LineService, logger *slog.Logger) *LineHandler {
+	return &LineHandler{
+		lineService: lineService,
+		logger:      logger,
+	}
+}
+
+func (handler *LineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	line, err := handler.lineService.GetLine(r.Context(), mux.Vars(r)["line"])
+	if errors.Is(err, domains.ErrLineNotFound) {
+		writeError(w, http.StatusNotFound, "line not found")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error loading line")
+		return
+	}
+
+	setCacheControl(w, r, linesMaxAge)
+	if err := renderers.Write(w, renderers.JSON{}, http.StatusOK, line); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering line", slog.Any("error", err))
+	}
+}
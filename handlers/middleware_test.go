@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/adapters"
+	"github.com/janritter/kvb-api/config"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/services"
+)
+
+// newMiddlewareRouter builds the middleware chain of the API subrouter in main around handler
+func newMiddlewareRouter(t *testing.T, handler http.Handler) http.Handler {
+	t.Helper()
+
+	clients := services.NewAPIClients([]config.APIKey{{Name: "test", Key: "secret", RateLimit: 100, Burst: 100}}, func(ratePerSecond float64, burst int) ports.RateLimiter {
+		limiter, err := adapters.NewLocalRateLimiter(ratePerSecond, burst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return limiter
+	})
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	router := mux.NewRouter()
+	router.Use(AccessLog(logger))
+	router.Use(APIKeyAuth(clients, logger))
+	router.Use(Compression)
+	router.Handle("/stream", handler)
+	return router
+}
+
+func TestMiddlewareChainFlushesStreamedResponses(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(newMiddlewareRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		io.WriteString(w, "first\n")
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("flushing through the middleware chain: %v", err)
+		}
+
+		// The client has to receive the first line before the handler finishes
+		<-release
+		io.WriteString(w, "second\n")
+	})))
+	// The handler has to finish before the server can close
+	defer server.Close()
+	defer close(release)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/stream", nil)
+	req.Header.Set("X-API-Key", "secret")
+	req.Header.Set("Accept-Encoding", "gzip")
+	// Without the flush reaching the connection neither the headers nor the first line arrive before the timeout
+	client := &http.Client{Timeout: 2 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if encoding := res.Header.Get("Content-Encoding"); encoding != "gzip" {
+		t.Fatalf("expected a gzip response, got Content-Encoding %q", encoding)
+	}
+
+	body, err := gzip.NewReader(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	line, err := bufio.NewReader(body).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if line != "first\n" {
+		t.Errorf("expected the first line, got %q", line)
+	}
+}
+
+func TestMiddlewareChainUnwrapsToTheServerWriter(t *testing.T) {
+	server := httptest.NewServer(newMiddlewareRouter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Errorf("setting the write deadline through the middleware chain: %v", err)
+		}
+	})))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/stream", nil)
+	req.Header.Set("X-API-Key", "secret")
+	req.Header.Set("Accept-Encoding", "gzip")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+// stationsMaxAge lets clients cache stations, they only change with a restart
+const stationsMaxAge = 3600
+
+type stationsResponse struct {
+	Stations []domains.Station `json:"stations"`
+}
+
+// StationsHandler lists all KVB stations with the details of the static GTFS feed, or those matching the q parameter best first
+type StationsHandler struct {
+	stationService ports.StationService
+	logger         *slog.Logger
+}
+
+func NewStationsHandler(stationService ports.StationService, logger *slog.Logger) *StationsHandler {
+	return &StationsHandler{
+		stationService: stationService,
+		logger:         logger,
+	}
+}
+
+func (handler *StationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var stations []domains.Station
+	var err error
+	if query := r.URL.Query().Get("q"); query != "" {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		stations, err = handler.stationService.SearchStations(r.Context(), query, limit)
+	} else {
+		stations, err = handler.stationService.GetStations(r.Context())
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error loading stations")
+		return
+	}
+
+	setCacheControl(w, r, stationsMaxAge)
+	if err := renderers.Write(w, renderers.JSON{}, http.StatusOK, stationsResponse{Stations: stations}); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering stations", slog.Any("error", err))
+	}
+}
+
+// StationHandler returns a single station by its KVB station ID
+type StationHandler struct {
+	stationService ports.StationService
+	logger         *slog.Logger
+}
+
+func NewStationHandler(stationService ports.StationService, logger *slog.Logger) *StationHandler {
+	return &StationHandler{
+		stationService: stationService,
+		logger:         logger,
+	}
+}
+
+func (handler *StationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stationID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "station ID must be a number")
+		return
+	}
+
+	station, err := handler.stationService.GetStationForID(r.Context(), stationID)
+	if errors.Is(err, domains.ErrStationNotFound) {
+		writeError(w, http.StatusNotFound, "station not found")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error loading station")
+		return
+	}
+
+	setCacheControl(w, r, stationsMaxAge)
+	if err := renderers.Write(w, renderers.JSON{}, http.StatusOK, station); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering station", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+const (
+	// defaultStatsWindow matches the default history retention
+	defaultStatsWindow = 7 * 24 * time.Hour
+	maxStatsWindow     = 31 * 24 * time.Hour
+	// maxLineStatsWindow is shorter, the stats of a line read the snapshots of every recorded station
+	maxLineStatsWindow = 7 * 24 * time.Hour
+	// statsMaxAge lets clients reuse stats for a while, they change slowly and are expensive to compute
+	statsMaxAge = 300
+)
+
+// StationStatsHandler returns the punctuality stats of a station by its KVB station ID
+type StationStatsHandler struct {
+	statsService ports.StatsService
+	logger       *slog.Logger
+}
+
+func NewStationStatsHandler(statsService ports.StatsService, logger *slog.Logger) *StationStatsHandler {
+	return &StationStatsHandler{
+		statsService: statsService,
+		logger:       logger,
+	}
+}
+
+func (handler *StationStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stationID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "station ID must be a number")
+		return
+	}
+
+	from, to, ok := parseTimeWindow(w, r, defaultStatsWindow, maxStatsWindow)
+	if !ok {
+		return
+	}
+
+	stats, err := handler.statsService.GetStationStats(r.Context(), stationID, from, to)
+	if errors.Is(err, domains.ErrStationNotFound) {
+		writeError(w, http.StatusNotFound, "station not found")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error computing stats")
+		return
+	}
+
+	setCacheControl(w, r, statsMaxAge)
+	if err := renderers.Write(w, renderers.JSON{}, http.StatusOK, stats); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering station stats", slog.Any("error", err))
+	}
+}
+
+// LineStatsHandler returns the punctuality stats of a line across all recorded stations
+type LineStatsHandler struct {
+	statsService ports.StatsService
+	logger       *slog.Logger
+}
+
+func NewLineStatsHandler(statsService ports.StatsService, logger *slog.Logger) *LineStatsHandler {
+	return &LineStatsHandler{
+		statsService: statsService,
+		logger:       logger,
+	}
+}
+
+func (handler *LineStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parseTimeWindow(w, r, defaultStatsWindow, maxLineStatsWindow)
+	if !ok {
+		return
+	}
+
+	stats, err := handler.statsService.GetLineStats(r.Context(), mux.Vars(r)["line"], from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error computing stats")
+		return
+	}
+
+	setCacheControl(w, r, statsMaxAge)
+	if err := renderers.Write(w, renderers.JSON{}, http.StatusOK, stats); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering line stats", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
+)
+
+// maxWebhookRequestSize limits the body of webhook rule requests
+const maxWebhookRequestSize = 64 << 10
+
+type webhookRequest struct {
+	Station       string `json:"station"`
+	Line          string `json:"line"`
+	Destination   string `json:"destination"`
+	MinutesBefore int    `json:"minutesBefore"`
+	URL           string `json:"url"`
+	// Secret is optional, a secret is generated on creation and kept on updates without it
+	Secret string `json:"secret"`
+}
+
+// webhookResponse leaves out the owner and returns the secret only on creation
+type webhookResponse struct {
+	ID            string    `json:"id"`
+	Station       string    `json:"station"`
+	Line          string    `json:"line,omitempty"`
+	Destination   string    `json:"destination,omitempty"`
+	MinutesBefore int       `json:"minutesBefore"`
+	URL           string    `json:"url"`
+	Secret        string    `json:"secret,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type webhooksResponse struct {
+	Webhooks []webhookResponse `json:"webhooks"`
+}
+
+func newWebhookResponse(rule domains.WebhookRule, withSecret bool) webhookResponse {
+	response := webhookResponse{
+		ID:            rule.ID,
+		Station:       rule.Station,
+		Line:          rule.Line,
+		Destination:   rule.Destination,
+		MinutesBefore: rule.MinutesBefore,
+		URL:           rule.URL,
+		CreatedAt:     rule.CreatedAt,
+		UpdatedAt:     rule.UpdatedAt,
+	}
+	if withSecret {
+		response.Secret = rule.Secret
+	}
+	return response
+}
+
+// WebhooksHandler lists the webhook rules of the client on GET and creates one on POST
+type WebhooksHandler struct {
+	webhookService ports.WebhookService
+	logger         *slog.Logger
+}
+
+func NewWebhooksHandler(webhookService ports.WebhookService, logger *slog.Logger) *WebhooksHandler {
+	return &WebhooksHandler{
+		webhookService: webhookService,
+		logger:         logger,
+	}
+}
+
+func (handler *WebhooksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		rules, err := handler.webhookService.ListWebhookRules(r.Context(), clientName(r))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "error loading webhooks")
+			return
+		}
+
+		response := webhooksResponse{Webhooks: []webhookResponse{}}
+		for _, rule := range rules {
+			response.Webhooks = append(response.Webhooks, newWebhookResponse(rule, false))
+		}
+		handler.write(w, r, http.StatusOK, response)
+
+	case http.MethodPost:
+		rule, ok := decodeWebhookRule(w, r)
+		if !ok {
+			return
+		}
+
+		created, err := handler.webhookService.CreateWebhookRule(r.Context(), rule)
+		if !handleWebhookError(w, err) {
+			return
+		}
+
+		w.Header().Set("Location", "/v1/webhooks/"+created.ID)
+		handler.write(w, r, http.StatusCreated, newWebhookResponse(created, true))
+
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (handler *WebhooksHandler) write(w http.ResponseWriter, r *http.Request, status int, v any) {
+	if err := renderers.Write(w, renderers.JSON{}, status, v); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering webhooks", slog.Any("error", err))
+	}
+}
+
+// WebhookHandler reads, replaces and deletes a single webhook rule of the client
+type WebhookHandler struct {
+	webhookService ports.WebhookService
+	logger         *slog.Logger
+}
+
+func NewWebhookHandler(webhookService ports.WebhookService, logger *slog.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		logger:         logger,
+	}
+}
+
+func (handler *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	owner := clientName(r)
+
+	switch r.Method {
+	case http.MethodGet:
+		rule, err := handler.webhookService.GetWebhookRule(r.Context(), owner, id)
+		if !handleWebhookError(w, err) {
+			return
+		}
+		handler.write(w, r, http.StatusOK, newWebhookResponse(rule, false))
+
+	case http.MethodPut:
+		rule, ok := decodeWebhookRule(w, r)
+		if !ok {
+			return
+		}
+		rule.ID = id
+
+		updated, err := handler.webhookService.UpdateWebhookRule(r.Context(), rule)
+		if !handleWebhookError(w, err) {
+			return
+		}
+		handler.write(w, r, http.StatusOK, newWebhookResponse(updated, false))
+
+	case http.MethodDelete:
+		if !handleWebhookError(w, handler.webhookService.DeleteWebhookRule(r.Context(), owner, id)) {
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.Header().Set("Allow", "GET, PUT, DELETE")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (handler *WebhookHandler) write(w http.ResponseWriter, r *http.Request, status int, v any) {
+	if err := renderers.Write(w, renderers.JSON{}, status, v); err != nil {
+		handler.logger.ErrorContext(r.Context(), "Error rendering webhook", slog.Any("error", err))
+	}
+}
+
+// decodeWebhookRule reads the rule from the request body, owned by the authenticated client
+func decodeWebhookRule(w http.ResponseWriter, r *http.Request) (domains.WebhookRule, bool) {
+	var request webhookRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookRequestSize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid webhook: "+err.Error())
+		return domains.WebhookRule{}, false
+	}
+
+	return domains.WebhookRule{
+		Owner:         clientName(r),
+		Station:       request.Station,
+		Line:          request.Line,
+		Destination:   request.Destination,
+		MinutesBefore: request.MinutesBefore,
+		URL:           request.URL,
+		Secret:        request.Secret,
+	}, true
+}
+
+// handleWebhookError writes the error response for err and reports whether the request can go on
+func handleWebhookError(w http.ResponseWriter, err error) bool {
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, domains.ErrWebhookRuleNotFound):
+		writeError(w, http.StatusNotFound, "webhook not found")
+	case errors.Is(err, domains.ErrInvalidWebhookRule):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "error storing webhook")
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"context"
+	"sync"
+)
+
+type accessRecordKey struct{}
+
+// AccessRecord collects request details from deeper layers for the access log
+type AccessRecord struct {
+	mu sync.Mutex
+
+	client       string
+	station      string
+	stationID    int
+	cacheOutcome string
+}
+
+// WithAccessRecord returns a context carrying an empty access record
+func WithAccessRecord(ctx context.Context) (context.Context, *AccessRecord) {
+	record := &AccessRecord{stationID: -1}
+	return context.WithValue(ctx, accessRecordKey{}, record), record
+}
+
+func accessRecordFromContext(ctx context.Context) *AccessRecord {
+	record, _ := ctx.Value(accessRecordKey{}).(*AccessRecord)
+	return record
+}
+
+// SetStation records the station resolved for the current request, no-op without access record
+func SetStation(ctx context.Context, name string, id int) {
+	record := accessRecordFromContext(ctx)
+	if record == nil {
+		return
+	}
+
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	record.station = name
+	record.stationID = id
+}
+
+// SetClient records the API client of the current request, no-op without access record
+func SetClient(ctx context.Context, name string) {
+	record := accessRecordFromContext(ctx)
+	if record == nil {
+		return
+	}
+
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	record.client = name
+}
+
+// SetCacheOutcome records whether the current request was served from cache, no-op without access record
+func SetCacheOutcome(ctx context.Context, outcome string) {
+	record := accessRecordFromContext(ctx)
+	if record == nil {
+		return
+	}
+
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	record.cacheOutcome = outcome
+}
+
+func (record *AccessRecord) Station() (string, int) {
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	return record.station, record.stationID
+}
+
+func (record *AccessRecord) CacheOutcome() string {
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	return record.cacheOutcome
+}
+
+func (record *AccessRecord) Client() string {
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	return record.client
+}
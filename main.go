@@ -2,15 +2,29 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"sort"
+	"syscall"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/janritter/kvb-api/adapters"
+	"github.com/janritter/kvb-api/config"
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/grpcapi"
+	"github.com/janritter/kvb-api/handlers"
+	"github.com/janritter/kvb-api/logging"
+	"github.com/janritter/kvb-api/ports"
+	"github.com/janritter/kvb-api/renderers"
 	"github.com/janritter/kvb-api/services"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
@@ -19,10 +33,13 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"google.golang.org/grpc"
 )
 
 const (
 	service = "kvb-api"
+	// shutdownTimeout bounds how long running requests are waited for on shutdown
+	shutdownTimeout = 10 * time.Second
 )
 
 func tracerProvider() (*tracesdk.TracerProvider, error) {
@@ -47,48 +64,479 @@ func tracerProvider() (*tracesdk.TracerProvider, error) {
 	return tp, nil
 }
 
+// newRateLimiter returns a limiter shared between replicas via Redis if a client is given, otherwise a local one
+func newRateLimiter(redisClient *redis.Client, prefix string, ratePerSecond float64, burst int) (ports.RateLimiter, error) {
+	if redisClient == nil {
+		limiter, err := adapters.NewLocalRateLimiter(ratePerSecond, burst)
+		if err != nil {
+			return nil, err
+		}
+		return limiter, nil
+	}
+
+	limiter, err := adapters.NewRedisRateLimiter(redisClient, "kvb-api:ratelimit:"+prefix+":", ratePerSecond, burst)
+	if err != nil {
+		return nil, err
+	}
+	return limiter, nil
+}
+
+// loadGTFSStatic imports the static GTFS feed, the stops of the GTFS mapping file override the name matching
+func loadGTFSStatic(cfg config.Config, stationMapperAdapter *adapters.StationMapperAdapter, gtfsMapping domains.GTFSMapping, logger *slog.Logger) (*adapters.GTFSStaticAdapter, error) {
+	stations, err := stationMapperAdapter.GetStations(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	gtfsStaticAdapter, err := adapters.NewGTFSStaticAdapter(cfg.GTFSStaticFile, stations, adapters.GTFSStaticOptions{
+		AgencyID:  cfg.GTFSStaticAgencyID,
+		Overrides: gtfsMapping.Stops,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Imported static GTFS feed", slog.Int("stations", len(stations)), slog.Int("matched", gtfsStaticAdapter.Matched()))
+	if invalid := gtfsStaticAdapter.InvalidOverrides(); len(invalid) > 0 {
+		logger.Warn("Stops of the GTFS mapping file missing in the static GTFS feed were ignored, the stations were matched by name", slog.Int("count", len(invalid)), slog.Any("stations", invalid))
+	}
+	if unmatched := gtfsStaticAdapter.Unmatched(); len(unmatched) > 0 {
+		logger.Warn("GTFS stops without matching KVB station, add them to the stops of the GTFS mapping file", slog.Int("count", len(unmatched)), slog.Any("stops", unmatched))
+	}
+
+	return gtfsStaticAdapter, nil
+}
+
+// startDeparturePublisher publishes departures via MQTT until the context is cancelled if a broker is configured, the
+// embedded broker is used if no other broker is given. The embedded broker is returned to be closed on shutdown.
+func startDeparturePublisher(ctx context.Context, cfg config.Config, stations []string, departureService ports.DepartureService, logger *slog.Logger) (*adapters.EmbeddedMQTTBroker, error) {
+	var broker *adapters.EmbeddedMQTTBroker
+	brokerURL := cfg.MQTTBrokerURL
+	if cfg.MQTTEmbeddedBrokerAddress != "" {
+		var err error
+		broker, err = adapters.StartEmbeddedMQTTBroker(adapters.EmbeddedMQTTBrokerOptions{
+			Address:  cfg.MQTTEmbeddedBrokerAddress,
+			Username: cfg.MQTTUsername,
+			Password: cfg.MQTTPassword,
+		}, logger)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("Running embedded MQTT broker", slog.String("address", broker.Address()), slog.Bool("authentication", cfg.MQTTUsername != ""))
+
+		if brokerURL == "" {
+			brokerURL = "tcp://" + broker.Address()
+		}
+	}
+	if brokerURL == "" || len(stations) == 0 {
+		return broker, nil
+	}
+
+	statusTopic := cfg.MQTTTopicPrefix + "/status"
+	mqttPublisher, err := adapters.NewMQTTPublisher(adapters.MQTTOptions{
+		BrokerURL:   brokerURL,
+		ClientID:    cfg.MQTTClientID,
+		Username:    cfg.MQTTUsername,
+		Password:    cfg.MQTTPassword,
+		QoS:         byte(cfg.MQTTQoS),
+		StatusTopic: statusTopic,
+	}, logger)
+	if err != nil {
+		return broker, err
+	}
+
+	options := services.DeparturePublisherOptions{
+		Stations:    stations,
+		Interval:    cfg.MQTTPublishInterval,
+		TopicPrefix: cfg.MQTTTopicPrefix,
+		StatusTopic: statusTopic,
+	}
+	if cfg.MQTTDiscovery {
+		options.DiscoveryPrefix = cfg.MQTTDiscoveryPrefix
+	}
+
+	logger.Info("Publishing departures via MQTT", slog.String("broker", brokerURL), slog.Any("stations", stations))
+	go func() {
+		services.NewDeparturePublisher(departureService, mqttPublisher, options, logger).Run(ctx)
+		mqttPublisher.Close()
+	}()
+
+	return broker, nil
+}
+
+// newKVBAdapter creates the adapter requesting KVB, or replaying recorded KVB responses if KVB_REPLAY_DIR is set
+func newKVBAdapter(cfg config.Config, redisClient *redis.Client, logger *slog.Logger) (*adapters.KVBAdapter, error) {
+	if cfg.KVBReplayDir != "" {
+		logger.Info("Replaying recorded KVB responses", slog.String("dir", cfg.KVBReplayDir))
+		return adapters.NewReplayKVBAdapter(cfg.KVBReplayDir, logger)
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if cfg.KVBRecordDir != "" {
+		logger.Info("Recording KVB responses", slog.String("dir", cfg.KVBRecordDir))
+		transport = adapters.NewRecordingTransport(transport, cfg.KVBRecordDir, logger)
+	}
+
+	globalLimiter, err := newRateLimiter(redisClient, "kvb", cfg.KVBRateLimitGlobal, cfg.KVBRateLimitGlobalBurst)
+	if err != nil {
+		return nil, fmt.Errorf("global KVB rate limit: %w", err)
+	}
+	stationLimiter, err := newRateLimiter(redisClient, "kvb", cfg.KVBRateLimitStation, cfg.KVBRateLimitStationBurst)
+	if err != nil {
+		return nil, fmt.Errorf("per station KVB rate limit: %w", err)
+	}
+	// KVB pages take the station ID as code parameter
+	return adapters.NewKVBAdapter(cfg.KVBBaseURL, adapters.NewRateLimitedTransport(transport, globalLimiter, stationLimiter, "code", cfg.KVBRateLimitMaxWait, logger), logger), nil
+}
+
+// newEFAAdapter creates the adapter requesting an EFA server, limited like KVB but with limits of its own per server
+func newEFAAdapter(cfg config.Config, redisClient *redis.Client, name string, baseURL string, format adapters.EFAFormat, logger *slog.Logger) (*adapters.EFAAdapter, error) {
+	globalLimiter, err := newRateLimiter(redisClient, "efa:"+name, cfg.KVBRateLimitGlobal, cfg.KVBRateLimitGlobalBurst)
+	if err != nil {
+		return nil, fmt.Errorf("global EFA rate limit: %w", err)
+	}
+	stationLimiter, err := newRateLimiter(redisClient, "efa:"+name, cfg.KVBRateLimitStation, cfg.KVBRateLimitStationBurst)
+	if err != nil {
+		return nil, fmt.Errorf("per stop EFA rate limit: %w", err)
+	}
+	// The departure monitor takes the stop ID as name_dm parameter
+	return adapters.NewEFAAdapter(baseURL, format, adapters.NewRateLimitedTransport(http.DefaultTransport, globalLimiter, stationLimiter, "name_dm", cfg.KVBRateLimitMaxWait, logger), logger), nil
+}
+
 func main() {
-	if os.Getenv("ENABLE_TRACING") == "true" {
-		log.Println("Configuring trace provider")
+	cfg := config.Load()
+	logger := logging.New(os.Stdout, cfg.LogLevel, cfg.LogFormat)
+
+	if err := cfg.ValidateIntervals(); err != nil {
+		logger.Error("Error loading intervals", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	apiKeys, err := cfg.LoadAPIKeys()
+	if err != nil {
+		logger.Error("Error loading API keys", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	boardGroups, err := cfg.LoadBoardGroups()
+	if err != nil {
+		logger.Error("Error loading board groups", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	gtfsRealtimeStationIDs, err := cfg.LoadGTFSRealtimeStationIDs()
+	if err != nil {
+		logger.Error("Error loading GTFS-Realtime stations", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	gtfsMapping, err := cfg.LoadGTFSMapping()
+	if err != nil {
+		logger.Error("Error loading GTFS mapping", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	mqttStations, err := cfg.LoadMQTTStations()
+	if err != nil {
+		logger.Error("Error loading MQTT stations", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	efaFormat, err := adapters.ParseEFAFormat(cfg.EFAFormat)
+	if err != nil {
+		logger.Error("Error loading EFA format", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	defaultKVBSource, kvbStationSources, err := cfg.LoadKVBSources()
+	if err != nil {
+		logger.Error("Error loading KVB departure sources", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	efaProviders, err := cfg.LoadEFAProviders()
+	if err != nil {
+		logger.Error("Error loading EFA providers", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	if cfg.EnableTracing {
+		logger.Info("Configuring trace provider")
 		tp, err := tracerProvider()
 		if err != nil {
-			log.Fatal(err)
+			logger.Error("Error configuring trace provider", slog.Any("error", err))
+			os.Exit(1)
 		}
 		otel.SetTracerProvider(tp)
 	}
 
 	otel.SetTextMapPropagator(propagation.TraceContext{})
 
-	kvbAdapter := adapters.NewKVBAdapter()
+	var redisClient *redis.Client
+	if cfg.RateLimitRedisAddress != "" {
+		redisClient = redis.NewClient(&redis.Options{Addr: cfg.RateLimitRedisAddress})
+	}
+
 	stationMapperAdapter := adapters.NewStationMapperAdapter()
-	departureService := services.New(stationMapperAdapter, kvbAdapter)
 
-	r := mux.NewRouter()
-	r.Use(otelmux.Middleware("kvb-api-webserver"))
+	var stationDetailsAdapter ports.StationDetailsAdapter
+	if cfg.GTFSStaticFile != "" {
+		gtfsStaticAdapter, err := loadGTFSStatic(cfg, stationMapperAdapter, gtfsMapping, logger)
+		if err != nil {
+			logger.Error("Error importing static GTFS feed", slog.Any("error", err))
+			os.Exit(1)
+		}
+		stationDetailsAdapter = gtfsStaticAdapter
+		// Matched stops and routes are used for the realtime feed and EFA unless the mapping file says otherwise
+		gtfsMapping = gtfsMapping.WithDefaults(gtfsStaticAdapter.StopIDs(), gtfsStaticAdapter.RouteIDs())
+	}
+
+	upstreamAdapter, err := newKVBAdapter(cfg, redisClient, logger)
+	if err != nil {
+		logger.Error("Error creating KVB adapter", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	resilientKVBAdapter := adapters.NewResilientKVBAdapter(upstreamAdapter, adapters.ResilienceOptions{
+		MaxAttempts:      cfg.KVBRetryMaxAttempts,
+		BaseDelay:        cfg.KVBRetryBaseDelay,
+		MaxDelay:         cfg.KVBRetryMaxDelay,
+		AttemptTimeout:   cfg.KVBRequestTimeout,
+		FailureThreshold: cfg.KVBBreakerFailureThreshold,
+		Cooldown:         cfg.KVBBreakerCooldown,
+	}, logger)
+
+	// EFA requests aren't retried, the KVB website is their fallback and vice versa
+	var sourceKVBAdapter ports.KVBAdapter = resilientKVBAdapter
+	if cfg.EFABaseURL != "" {
+		logger.Info("Using EFA for KVB stations", slog.String("url", cfg.EFABaseURL), slog.String("defaultSource", string(defaultKVBSource)))
+		efaAdapter, err := newEFAAdapter(cfg, redisClient, domains.DefaultProvider, cfg.EFABaseURL, efaFormat, logger)
+		if err != nil {
+			logger.Error("Error creating EFA adapter", slog.Any("error", err))
+			os.Exit(1)
+		}
+		sourceKVBAdapter = adapters.NewSourceKVBAdapter(resilientKVBAdapter, efaAdapter, adapters.SourceOptions{
+			Default:  defaultKVBSource,
+			Stations: kvbStationSources,
+			StopIDs:  gtfsMapping.Stops,
+		}, logger)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Snapshots are recorded below the cache, so only results actually fetched from KVB are stored
+	var upstreamKVBAdapter ports.KVBAdapter = sourceKVBAdapter
+	var historyService ports.HistoryService
+	var statsService ports.StatsService
+	var historyRepository *adapters.BoltDepartureHistoryRepository
+	if cfg.HistoryFile != "" {
+		historyRepository, err = adapters.NewBoltDepartureHistoryRepository(cfg.HistoryFile)
+		if err != nil {
+			logger.Error("Error opening departure history", slog.Any("error", err))
+			os.Exit(1)
+		}
+
+		upstreamKVBAdapter = adapters.NewRecordingKVBAdapter(sourceKVBAdapter, historyRepository, logger)
+		history := services.NewHistoryService(historyRepository, stationMapperAdapter, cfg.HistoryDownsampleInterval, logger)
+		historyService = history
+		statsService = history
+		go services.NewHistoryRetention(historyRepository, services.HistoryRetentionOptions{
+			Retention:          cfg.HistoryRetention,
+			DownsampleAfter:    cfg.HistoryDownsampleAfter,
+			DownsampleInterval: cfg.HistoryDownsampleInterval,
+			Interval:           cfg.HistoryMaintenanceInterval,
+		}, logger).Run(ctx)
+	}
+
+	cacheOptions := adapters.CacheOptions{
+		TTL:                  cfg.CacheTTL,
+		StaleWhileRevalidate: cfg.CacheStaleWhileRevalidate,
+		MaxStaleness:         cfg.CacheMaxStaleness,
+	}
+	kvbAdapter := adapters.NewCachedKVBAdapter(upstreamKVBAdapter, cacheOptions, logger)
+
+	lineRegistryAdapter, err := adapters.NewLineRegistryAdapter()
+	if err != nil {
+		logger.Error("Error loading line registry", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	providers := []ports.DepartureProvider{adapters.NewKVBProvider(kvbAdapter, stationMapperAdapter)}
+	efaProviderNames := make([]string, 0, len(efaProviders))
+	for name := range efaProviders {
+		efaProviderNames = append(efaProviderNames, name)
+	}
+	sort.Strings(efaProviderNames)
+	for _, name := range efaProviderNames {
+		efaAdapter, err := newEFAAdapter(cfg, redisClient, name, efaProviders[name], efaFormat, logger)
+		if err != nil {
+			logger.Error("Error creating EFA provider", slog.String("provider", name), slog.Any("error", err))
+			os.Exit(1)
+		}
+		providers = append(providers, adapters.NewCachedDepartureProvider(adapters.NewEFAProvider(name, efaAdapter), cacheOptions, logger))
+	}
+	departureService := services.New(stationMapperAdapter, stationDetailsAdapter, lineRegistryAdapter, providers, logger)
+
+	mqttBroker, err := startDeparturePublisher(ctx, cfg, mqttStations, departureService, logger)
+	if err != nil {
+		logger.Error("Error starting MQTT publisher", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	var webhookService ports.WebhookService
+	if cfg.WebhooksEnabled {
+		webhookRuleRepository, err := adapters.NewFileWebhookRuleRepository(cfg.WebhookRulesFile)
+		if err != nil {
+			logger.Error("Error loading webhook rules", slog.Any("error", err))
+			os.Exit(1)
+		}
+		if cfg.WebhookRulesFile == "" {
+			logger.Warn("WEBHOOK_RULES_FILE is not set, webhook rules are lost on restart")
+		}
+
+		webhookSender := adapters.NewHTTPWebhookSender(adapters.WebhookSenderOptions{
+			MaxAttempts:         cfg.WebhookMaxAttempts,
+			BaseDelay:           cfg.WebhookRetryBaseDelay,
+			MaxDelay:            cfg.WebhookRetryMaxDelay,
+			AttemptTimeout:      cfg.WebhookRequestTimeout,
+			AllowPrivateTargets: cfg.WebhookAllowPrivateTargets,
+		}, logger)
+		webhookService = services.NewWebhookService(webhookRuleRepository, webhookSender, logger)
+		go services.NewWebhookScheduler(departureService, webhookRuleRepository, webhookRuleRepository, webhookSender, cfg.WebhookPollInterval, logger).Run(ctx)
+	}
 
-	r.HandleFunc("/v1/departures/stations/{key}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		searchStation := vars["key"]
+	graphQLHandler, err := handlers.NewGraphQLHandler(departureService, departureService, departureService, handlers.GraphQLLimits{
+		MaxDepth:      cfg.GraphQLMaxDepth,
+		MaxComplexity: cfg.GraphQLMaxComplexity,
+	}, logger)
+	if err != nil {
+		logger.Error("Error building GraphQL schema", slog.Any("error", err))
+		os.Exit(1)
+	}
 
-		departures, _ := departureService.GetDeparturesForMatchingStation(r.Context(), searchStation)
-		payload, err := json.Marshal(departures)
+	// The rate limits of API keys are validated when loading them, so creating their limiters doesn't fail
+	newClientLimiter := func(ratePerSecond float64, burst int) ports.RateLimiter {
+		limiter, err := newRateLimiter(redisClient, "clients", ratePerSecond, burst)
 		if err != nil {
-			log.Printf("Error marshalling departures: %s", err)
+			logger.Error("Error creating client rate limiter", slog.Any("error", err))
+			os.Exit(1)
 		}
+		return limiter
+	}
+	// HTTP and gRPC share the clients, so a key has one rate limit across both APIs
+	apiClients := services.NewAPIClients(apiKeys, newClientLimiter)
+
+	// An open breaker fails every request fast, both /readyz and the gRPC health status report it
+	kvbAvailable := func() bool {
+		return resilientKVBAdapter.BreakerState() != adapters.BreakerOpen
+	}
+
+	r := mux.NewRouter()
 
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(payload)
+	r.HandleFunc("/healthz", handlers.Healthz)
+	r.Handle("/readyz", handlers.Readyz(handlers.ReadinessCheck{
+		Name: "kvb_upstream",
+		Check: func(ctx context.Context) (handlers.CheckStatus, string) {
+			detail := "circuit breaker " + resilientKVBAdapter.BreakerState().String()
+			if !kvbAvailable() {
+				return handlers.CheckDegraded, detail
+			}
+			return handlers.CheckOK, detail
+		},
 	}))
+	r.Handle("/metrics", promhttp.Handler())
+
+	api := r.NewRoute().Subrouter()
+	api.Use(otelmux.Middleware("kvb-api-webserver"))
+	api.Use(handlers.AccessLog(logger))
+	api.Use(handlers.APIKeyAuth(apiClients, logger))
+	api.Use(handlers.Compression)
+
+	api.Handle("/v1/departures/stations/{key}.png", handlers.NewBoardImageHandler(departureService, handlers.BoardPNG, cfg.CacheTTL, logger))
+	api.Handle("/v1/departures/stations/{key}.svg", handlers.NewBoardImageHandler(departureService, handlers.BoardSVG, cfg.CacheTTL, logger))
+	api.Handle("/v1/departures/stations/{key}", handlers.NewDeparturesHandler(departureService, renderers.Default, cfg.CacheTTL, logger))
+	api.Handle("/v1/departures/stations/{key}/calendar.ics", handlers.NewCalendarHandler(departureService, cfg.CacheTTL, logger))
+	api.Handle("/v1/gtfs-rt/trip-updates", handlers.NewGTFSRealtimeHandler(departureService, gtfsRealtimeStationIDs, gtfsMapping, logger))
+	api.Handle("/v1/stations", handlers.NewStationsHandler(departureService, logger))
+	api.Handle("/v1/stations/{id}", handlers.NewStationHandler(departureService, logger))
+	api.Handle("/v1/lines", handlers.NewLinesHandler(departureService, logger))
+	api.Handle("/v1/lines/{line}", handlers.NewLineHandler(departureService, logger))
+	if webhookService != nil {
+		api.Handle("/v1/webhooks", handlers.NewWebhooksHandler(webhookService, logger))
+		api.Handle("/v1/webhooks/{id}", handlers.NewWebhookHandler(webhookService, logger))
+	}
+	if historyService != nil {
+		api.Handle("/v1/history/stations/{id}", handlers.NewHistoryHandler(historyService, logger))
+		api.Handle("/v1/stats/stations/{id}", handlers.NewStationStatsHandler(statsService, logger))
+		api.Handle("/v1/stats/lines/{line}", handlers.NewLineStatsHandler(statsService, logger))
+	}
+	api.Handle("/graphql", graphQLHandler)
+	api.Handle("/board/{station}", handlers.NewBoardHandler(departureService, boardGroups, logger))
+
+	var grpcServer *grpc.Server
+	if cfg.GRPCListenAddress != "" {
+		listener, err := net.Listen("tcp", cfg.GRPCListenAddress)
+		if err != nil {
+			logger.Error("Error listening for gRPC", slog.Any("error", err))
+			os.Exit(1)
+		}
+
+		grpcServer = grpcapi.NewServer(ctx, grpcapi.NewDepartureServer(ctx, departureService, departureService), apiClients, kvbAvailable, logger)
+		logger.Info("Running gRPC server", slog.String("address", cfg.GRPCListenAddress))
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.Error("gRPC server stopped", slog.Any("error", err))
+				os.Exit(1)
+			}
+		}()
+	}
 
 	srv := &http.Server{
 		Handler: r,
-		Addr:    ":8080",
+		Addr:    cfg.ListenAddress,
 
 		WriteTimeout: 15 * time.Second,
 		ReadTimeout:  15 * time.Second,
 	}
 
-	log.Println("Running webserver on port 8080")
+	logger.Info("Running webserver", slog.String("address", cfg.ListenAddress))
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Webserver stopped", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Shutting down")
 
-	log.Fatal(srv.ListenAndServe())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("Error shutting down webserver", slog.Any("error", err))
+	}
+	if grpcServer != nil {
+		// Watch streams end with ctx, other calls get until the shutdown timeout to finish
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-shutdownCtx.Done():
+			logger.Warn("gRPC server didn't stop in time, closing its connections")
+			grpcServer.Stop()
+		}
+	}
+	if mqttBroker != nil {
+		mqttBroker.Close()
+	}
+	// Closed last, requests still being answered above record their snapshots
+	if historyRepository != nil {
+		if err := historyRepository.Close(); err != nil {
+			logger.Warn("Error closing departure history", slog.Any("error", err))
+		}
+	}
 }
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "kvb_api"
+
+var (
+	// CircuitBreakerState is 0 for closed, 1 for half-open and 2 for open
+	CircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_state",
+		Help:      "State of the circuit breaker (0 closed, 1 half-open, 2 open)",
+	}, []string{"breaker"})
+
+	UpstreamRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upstream_requests_total",
+		Help:      "Requests sent to the upstream by outcome",
+	}, []string{"upstream", "outcome"})
+
+	UpstreamRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upstream_retries_total",
+		Help:      "Retries of failed upstream requests",
+	}, []string{"upstream"})
+
+	UpstreamRateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upstream_rate_limited_total",
+		Help:      "Upstream requests rejected by the outbound rate limiter by scope",
+	}, []string{"scope"})
+
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Departure cache lookups by outcome",
+	}, []string{"outcome"})
+
+	ClientRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "client_requests_total",
+		Help:      "API requests per client by outcome",
+	}, []string{"client", "outcome"})
+
+	WebhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "Webhook delivery attempts by outcome",
+	}, []string{"outcome"})
+
+	HistorySnapshots = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "history_snapshots_total",
+		Help:      "Departure snapshots handed to the history recorder by outcome",
+	}, []string{"outcome"})
+
+	DepartureSourceFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "departure_source_fallbacks_total",
+		Help:      "Departure requests sent to the other source after the configured source failed",
+	}, []string{"from", "to"})
+)
@@ -8,4 +8,7 @@ import (
 
 type DepartureService interface {
 	GetDeparturesForMatchingStation(ctx context.Context, station string) (domains.Departures, error)
+	GetDeparturesForStationID(ctx context.Context, stationID int) (domains.Departures, error)
+	// GetDeparturesForStation returns the departures of a station of any enabled provider
+	GetDeparturesForStation(ctx context.Context, ref domains.StationRef) (domains.Departures, error)
 }
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+type HistoryService interface {
+	GetSnapshots(ctx context.Context, stationID int, from time.Time, to time.Time) ([]domains.DepartureSnapshot, error)
+}
+
+type DepartureHistoryRepository interface {
+	SaveSnapshot(ctx context.Context, snapshot domains.DepartureSnapshot) error
+	// ListStationIDs returns the IDs of all stations with recorded snapshots
+	ListStationIDs(ctx context.Context) ([]int, error)
+	// ListSnapshots returns the snapshots of a station fetched in [from, to) ordered by fetch time
+	ListSnapshots(ctx context.Context, stationID int, from time.Time, to time.Time) ([]domains.DepartureSnapshot, error)
+	// DeleteSnapshotsBefore removes all snapshots fetched before the time and returns their number
+	DeleteSnapshotsBefore(ctx context.Context, before time.Time) (int, error)
+	// Downsample keeps one snapshot per station and interval of those fetched before the time and returns the number removed
+	Downsample(ctx context.Context, before time.Time, interval time.Duration) (int, error)
+	// Compact reclaims the space of removed snapshots
+	Compact(ctx context.Context) error
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+type LineService interface {
+	GetLines(ctx context.Context) ([]domains.Line, error)
+	GetLine(ctx context.Context, name string) (domains.Line, error)
+}
+
+type LineRegistryAdapter interface {
+	GetLines(ctx context.Context) ([]domains.Line, error)
+	GetLine(ctx context.Context, name string) (domains.Line, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// DepartureProvider is a transit operator serving the departures of its stations
+type DepartureProvider interface {
+	// Name is the prefix of the provider-qualified IDs of its stations, e.g. kvb
+	Name() string
+	// GetDepartures returns the departures of the station with the provider's own station ID
+	GetDepartures(ctx context.Context, stationID string) (domains.Departures, error)
+	// SearchStations returns up to limit stations matching the query, best match first, with provider-qualified refs
+	SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error)
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "context"
+
+type MessagePublisher interface {
+	// Publish sends a retained message, so subscribers get the latest state when they connect
+	Publish(ctx context.Context, topic string, payload []byte) error
+}
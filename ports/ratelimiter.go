@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+type Reservation struct {
+	// OK is false if no token could be reserved within the maximum wait
+	OK bool
+	// Wait is the time to wait before using the token, or the time until the next token is available if OK is false
+	Wait time.Duration
+	// Remaining is the number of tokens left in the bucket
+	Remaining int
+}
+
+type RateLimiter interface {
+	// Reserve takes a token for key if one becomes available within maxWait
+	Reserve(ctx context.Context, key string, maxWait time.Duration) (Reservation, error)
+	// Cancel returns a reserved token of key which won't be used
+	Cancel(ctx context.Context, key string) error
+}
@@ -1,7 +1,14 @@
 package ports
 
-import "context"
+import (
+	"context"
+
+	"github.com/janritter/kvb-api/domains"
+)
 
 type StationMapperAdapter interface {
-	GetStationIDForName(ctx context.Context, name string) (int, error)
+	GetStationForName(ctx context.Context, name string) (domains.Station, error)
+	GetStationForID(ctx context.Context, stationID int) (domains.Station, error)
+	GetStations(ctx context.Context) ([]domains.Station, error)
+	SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error)
 }
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+type StationService interface {
+	GetStations(ctx context.Context) ([]domains.Station, error)
+	GetStationForID(ctx context.Context, stationID int) (domains.Station, error)
+	SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error)
+	// GetNearbyStations returns up to limit stations within radius meters of the station, closest first.
+	// Distances are only known with a static GTFS feed, without it no stations are returned.
+	GetNearbyStations(ctx context.Context, stationID int, radius float64, limit int) ([]domains.NearbyStation, error)
+}
+
+type StationDetailsAdapter interface {
+	GetStationDetails(ctx context.Context, stationID int) (domains.StationDetails, bool)
+}
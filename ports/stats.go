@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+type StatsService interface {
+	GetStationStats(ctx context.Context, stationID int, from time.Time, to time.Time) (domains.PunctualityStats, error)
+	GetLineStats(ctx context.Context, line string, from time.Time, to time.Time) (domains.PunctualityStats, error)
+}
@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+type WebhookService interface {
+	ListWebhookRules(ctx context.Context, owner string) ([]domains.WebhookRule, error)
+	GetWebhookRule(ctx context.Context, owner string, id string) (domains.WebhookRule, error)
+	CreateWebhookRule(ctx context.Context, rule domains.WebhookRule) (domains.WebhookRule, error)
+	UpdateWebhookRule(ctx context.Context, rule domains.WebhookRule) (domains.WebhookRule, error)
+	DeleteWebhookRule(ctx context.Context, owner string, id string) error
+}
+
+type WebhookRuleRepository interface {
+	ListWebhookRules(ctx context.Context) ([]domains.WebhookRule, error)
+	GetWebhookRule(ctx context.Context, id string) (domains.WebhookRule, error)
+	SaveWebhookRule(ctx context.Context, rule domains.WebhookRule) error
+	DeleteWebhookRule(ctx context.Context, id string) error
+}
+
+// WebhookDeliveryRepository remembers the alerted departures of the rules, so restarts don't alert them again
+type WebhookDeliveryRepository interface {
+	// ListWebhookDeliveries returns the deliveries by rule ID
+	ListWebhookDeliveries(ctx context.Context) (map[string][]domains.WebhookDelivery, error)
+	// SaveWebhookDeliveries replaces all stored deliveries
+	SaveWebhookDeliveries(ctx context.Context, deliveries map[string][]domains.WebhookDelivery) error
+}
+
+type WebhookSender interface {
+	// SendWebhook posts the signed payload to the URL, deliveryID stays the same across retries of a delivery
+	SendWebhook(ctx context.Context, url string, secret string, deliveryID string, payload []byte) error
+	// CheckWebhookURL returns an error wrapping domains.ErrInvalidWebhookRule if webhooks can't be sent to the URL
+	CheckWebhookURL(ctx context.Context, url string) error
+}
@@ -0,0 +1,1465 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: kvb/v1/departures.proto
+
+package kvbv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LineMode int32
+
+const (
+	LineMode_LINE_MODE_UNSPECIFIED LineMode = 0
+	LineMode_LINE_MODE_LIGHT_RAIL  LineMode = 1
+	LineMode_LINE_MODE_BUS         LineMode = 2
+	LineMode_LINE_MODE_NIGHT_BUS   LineMode = 3
+)
+
+// Enum value maps for LineMode.
+var (
+	LineMode_name = map[int32]string{
+		0: "LINE_MODE_UNSPECIFIED",
+		1: "LINE_MODE_LIGHT_RAIL",
+		2: "LINE_MODE_BUS",
+		3: "LINE_MODE_NIGHT_BUS",
+	}
+	LineMode_value = map[string]int32{
+		"LINE_MODE_UNSPECIFIED": 0,
+		"LINE_MODE_LIGHT_RAIL":  1,
+		"LINE_MODE_BUS":         2,
+		"LINE_MODE_NIGHT_BUS":   3,
+	}
+)
+
+func (x LineMode) Enum() *LineMode {
+	p := new(LineMode)
+	*p = x
+	return p
+}
+
+func (x LineMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LineMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_kvb_v1_departures_proto_enumTypes[0].Descriptor()
+}
+
+func (LineMode) Type() protoreflect.EnumType {
+	return &file_kvb_v1_departures_proto_enumTypes[0]
+}
+
+func (x LineMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LineMode.Descriptor instead.
+func (LineMode) EnumDescriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{0}
+}
+
+type GetDeparturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Station:
+	//	*GetDeparturesRequest_StationId
+	//	*GetDeparturesRequest_StationName
+	//	*GetDeparturesRequest_StationRef
+	Station isGetDeparturesRequest_Station `protobuf_oneof:"station"`
+	// lines limits the departures to these lines
+	Lines []string `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	// destination limits the departures to destinations containing it, case-insensitive
+	Destination string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	// limit is the maximum number of departures, 0 returns all
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetDeparturesRequest) Reset() {
+	*x = GetDeparturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeparturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeparturesRequest) ProtoMessage() {}
+
+func (x *GetDeparturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeparturesRequest.ProtoReflect.Descriptor instead.
+func (*GetDeparturesRequest) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *GetDeparturesRequest) GetStation() isGetDeparturesRequest_Station {
+	if m != nil {
+		return m.Station
+	}
+	return nil
+}
+
+func (x *GetDeparturesRequest) GetStationId() int32 {
+	if x, ok := x.GetStation().(*GetDeparturesRequest_StationId); ok {
+		return x.StationId
+	}
+	return 0
+}
+
+func (x *GetDeparturesRequest) GetStationName() string {
+	if x, ok := x.GetStation().(*GetDeparturesRequest_StationName); ok {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *GetDeparturesRequest) GetStationRef() string {
+	if x, ok := x.GetStation().(*GetDeparturesRequest_StationRef); ok {
+		return x.StationRef
+	}
+	return ""
+}
+
+func (x *GetDeparturesRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetDeparturesRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *GetDeparturesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type isGetDeparturesRequest_Station interface {
+	isGetDeparturesRequest_Station()
+}
+
+type GetDeparturesRequest_StationId struct {
+	// station_id is the KVB station ID
+	StationId int32 `protobuf:"varint,1,opt,name=station_id,json=stationId,proto3,oneof"`
+}
+
+type GetDeparturesRequest_StationName struct {
+	// station_name is matched fuzzy like the station of the HTTP API
+	StationName string `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3,oneof"`
+}
+
+type GetDeparturesRequest_StationRef struct {
+	// station_ref is the provider-qualified station ID, e.g. kvb:2, as returned by SearchStations
+	StationRef string `protobuf:"bytes,6,opt,name=station_ref,json=stationRef,proto3,oneof"`
+}
+
+func (*GetDeparturesRequest_StationId) isGetDeparturesRequest_Station() {}
+
+func (*GetDeparturesRequest_StationName) isGetDeparturesRequest_Station() {}
+
+func (*GetDeparturesRequest_StationRef) isGetDeparturesRequest_Station() {}
+
+type GetDeparturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Departures *Departures `protobuf:"bytes,1,opt,name=departures,proto3" json:"departures,omitempty"`
+}
+
+func (x *GetDeparturesResponse) Reset() {
+	*x = GetDeparturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeparturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeparturesResponse) ProtoMessage() {}
+
+func (x *GetDeparturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeparturesResponse.ProtoReflect.Descriptor instead.
+func (*GetDeparturesResponse) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDeparturesResponse) GetDepartures() *Departures {
+	if x != nil {
+		return x.Departures
+	}
+	return nil
+}
+
+type BatchGetDeparturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*GetDeparturesRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchGetDeparturesRequest) Reset() {
+	*x = BatchGetDeparturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetDeparturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetDeparturesRequest) ProtoMessage() {}
+
+func (x *BatchGetDeparturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetDeparturesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetDeparturesRequest) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchGetDeparturesRequest) GetRequests() []*GetDeparturesRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchGetDeparturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are in the order of the requests
+	Results []*BatchGetDeparturesResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchGetDeparturesResponse) Reset() {
+	*x = BatchGetDeparturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetDeparturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetDeparturesResponse) ProtoMessage() {}
+
+func (x *BatchGetDeparturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetDeparturesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetDeparturesResponse) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchGetDeparturesResponse) GetResults() []*BatchGetDeparturesResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchGetDeparturesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*BatchGetDeparturesResult_Departures
+	//	*BatchGetDeparturesResult_Error
+	Result isBatchGetDeparturesResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *BatchGetDeparturesResult) Reset() {
+	*x = BatchGetDeparturesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetDeparturesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetDeparturesResult) ProtoMessage() {}
+
+func (x *BatchGetDeparturesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetDeparturesResult.ProtoReflect.Descriptor instead.
+func (*BatchGetDeparturesResult) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *BatchGetDeparturesResult) GetResult() isBatchGetDeparturesResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *BatchGetDeparturesResult) GetDepartures() *Departures {
+	if x, ok := x.GetResult().(*BatchGetDeparturesResult_Departures); ok {
+		return x.Departures
+	}
+	return nil
+}
+
+func (x *BatchGetDeparturesResult) GetError() string {
+	if x, ok := x.GetResult().(*BatchGetDeparturesResult_Error); ok {
+		return x.Error
+	}
+	return ""
+}
+
+type isBatchGetDeparturesResult_Result interface {
+	isBatchGetDeparturesResult_Result()
+}
+
+type BatchGetDeparturesResult_Departures struct {
+	Departures *Departures `protobuf:"bytes,1,opt,name=departures,proto3,oneof"`
+}
+
+type BatchGetDeparturesResult_Error struct {
+	// error describes why the departures of the station couldn't be fetched
+	Error string `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchGetDeparturesResult_Departures) isBatchGetDeparturesResult_Result() {}
+
+func (*BatchGetDeparturesResult_Error) isBatchGetDeparturesResult_Result() {}
+
+type SearchStationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is the maximum number of stations, 0 returns all matches
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchStationsRequest) Reset() {
+	*x = SearchStationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStationsRequest) ProtoMessage() {}
+
+func (x *SearchStationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchStationsRequest) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchStationsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchStationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchStationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stations []*Station `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+}
+
+func (x *SearchStationsResponse) Reset() {
+	*x = SearchStationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStationsResponse) ProtoMessage() {}
+
+func (x *SearchStationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchStationsResponse) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchStationsResponse) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type WatchDeparturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *GetDeparturesRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// interval between polls of the departures, defaults to 30s and is at least 10s
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *WatchDeparturesRequest) Reset() {
+	*x = WatchDeparturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDeparturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeparturesRequest) ProtoMessage() {}
+
+func (x *WatchDeparturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeparturesRequest.ProtoReflect.Descriptor instead.
+func (*WatchDeparturesRequest) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchDeparturesRequest) GetRequest() *GetDeparturesRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *WatchDeparturesRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type WatchDeparturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Departures *Departures `protobuf:"bytes,1,opt,name=departures,proto3" json:"departures,omitempty"`
+}
+
+func (x *WatchDeparturesResponse) Reset() {
+	*x = WatchDeparturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDeparturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeparturesResponse) ProtoMessage() {}
+
+func (x *WatchDeparturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeparturesResponse.ProtoReflect.Descriptor instead.
+func (*WatchDeparturesResponse) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchDeparturesResponse) GetDepartures() *Departures {
+	if x != nil {
+		return x.Departures
+	}
+	return nil
+}
+
+type Departures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station    string       `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Departures []*Departure `protobuf:"bytes,2,rep,name=departures,proto3" json:"departures,omitempty"`
+	// stale departures are served from the cache because KVB couldn't be reached
+	Stale     bool                   `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+	FetchedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+}
+
+func (x *Departures) Reset() {
+	*x = Departures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Departures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Departures) ProtoMessage() {}
+
+func (x *Departures) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Departures.ProtoReflect.Descriptor instead.
+func (*Departures) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Departures) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *Departures) GetDepartures() []*Departure {
+	if x != nil {
+		return x.Departures
+	}
+	return nil
+}
+
+func (x *Departures) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *Departures) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+type Departure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line             string `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	Destination      string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	ArrivalInMinutes int32  `protobuf:"varint,3,opt,name=arrival_in_minutes,json=arrivalInMinutes,proto3" json:"arrival_in_minutes,omitempty"`
+	// line_details are unset for lines unknown to the line registry
+	LineDetails *Line `protobuf:"bytes,4,opt,name=line_details,json=lineDetails,proto3" json:"line_details,omitempty"`
+	// platform and planned_time are only known for departures from EFA
+	Platform    string                 `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"`
+	PlannedTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=planned_time,json=plannedTime,proto3" json:"planned_time,omitempty"`
+	// delay_minutes is only known for departures from EFA with real-time data
+	DelayMinutes *int32 `protobuf:"varint,7,opt,name=delay_minutes,json=delayMinutes,proto3,oneof" json:"delay_minutes,omitempty"`
+}
+
+func (x *Departure) Reset() {
+	*x = Departure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Departure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Departure) ProtoMessage() {}
+
+func (x *Departure) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Departure.ProtoReflect.Descriptor instead.
+func (*Departure) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Departure) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *Departure) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Departure) GetArrivalInMinutes() int32 {
+	if x != nil {
+		return x.ArrivalInMinutes
+	}
+	return 0
+}
+
+func (x *Departure) GetLineDetails() *Line {
+	if x != nil {
+		return x.LineDetails
+	}
+	return nil
+}
+
+func (x *Departure) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Departure) GetPlannedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlannedTime
+	}
+	return nil
+}
+
+func (x *Departure) GetDelayMinutes() int32 {
+	if x != nil && x.DelayMinutes != nil {
+		return *x.DelayMinutes
+	}
+	return 0
+}
+
+type Line struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Mode      LineMode `protobuf:"varint,2,opt,name=mode,proto3,enum=kvb.v1.LineMode" json:"mode,omitempty"`
+	Color     string   `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	TextColor string   `protobuf:"bytes,4,opt,name=text_color,json=textColor,proto3" json:"text_color,omitempty"`
+	Terminals []string `protobuf:"bytes,5,rep,name=terminals,proto3" json:"terminals,omitempty"`
+}
+
+func (x *Line) Reset() {
+	*x = Line{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Line) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Line) ProtoMessage() {}
+
+func (x *Line) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Line.ProtoReflect.Descriptor instead.
+func (*Line) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Line) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Line) GetMode() LineMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LineMode_LINE_MODE_UNSPECIFIED
+}
+
+func (x *Line) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Line) GetTextColor() string {
+	if x != nil {
+		return x.TextColor
+	}
+	return ""
+}
+
+func (x *Line) GetTerminals() []string {
+	if x != nil {
+		return x.Terminals
+	}
+	return nil
+}
+
+type Station struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the KVB station ID, 0 for stations of other providers
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// details are only known for stations matched to a stop of the static GTFS feed
+	Details *StationDetails `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// ref is the provider-qualified station ID, e.g. kvb:2
+	Ref string `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Station) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Station) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Station) GetDetails() *StationDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Station) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type StationDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StopId    string   `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	Latitude  float64  `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64  `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Routes    []string `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes,omitempty"`
+	// wheelchair_accessible is unset if the feed doesn't know whether the station is accessible
+	WheelchairAccessible *bool `protobuf:"varint,5,opt,name=wheelchair_accessible,json=wheelchairAccessible,proto3,oneof" json:"wheelchair_accessible,omitempty"`
+}
+
+func (x *StationDetails) Reset() {
+	*x = StationDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kvb_v1_departures_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationDetails) ProtoMessage() {}
+
+func (x *StationDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_kvb_v1_departures_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationDetails.ProtoReflect.Descriptor instead.
+func (*StationDetails) Descriptor() ([]byte, []int) {
+	return file_kvb_v1_departures_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StationDetails) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *StationDetails) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *StationDetails) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *StationDetails) GetRoutes() []string {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *StationDetails) GetWheelchairAccessible() bool {
+	if x != nil && x.WheelchairAccessible != nil {
+		return *x.WheelchairAccessible
+	}
+	return false
+}
+
+var File_kvb_v1_departures_proto protoreflect.FileDescriptor
+
+var file_kvb_v1_departures_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x6b, 0x76, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6b, 0x76, 0x62, 0x2e, 0x76,
+	0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x76, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x19, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x62, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x22, 0x58, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x18, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x76,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x48,
+	0x00, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x43, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x16,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x4d, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
+	0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xb7, 0x02, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x76, 0x62, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x04,
+	0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x73, 0x22, 0x71, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x65, 0x66, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x70, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x15, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x72,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x14, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x72, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x2a, 0x6b, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54,
+	0x5f, 0x52, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x42, 0x55,
+	0x53, 0x10, 0x03, 0x32, 0xe4, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6b, 0x76, 0x62, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6b,
+	0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x76, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x6e, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x2f, 0x6b, 0x76, 0x62, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6b, 0x76, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x76, 0x62, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kvb_v1_departures_proto_rawDescOnce sync.Once
+	file_kvb_v1_departures_proto_rawDescData = file_kvb_v1_departures_proto_rawDesc
+)
+
+func file_kvb_v1_departures_proto_rawDescGZIP() []byte {
+	file_kvb_v1_departures_proto_rawDescOnce.Do(func() {
+		file_kvb_v1_departures_proto_rawDescData = protoimpl.X.CompressGZIP(file_kvb_v1_departures_proto_rawDescData)
+	})
+	return file_kvb_v1_departures_proto_rawDescData
+}
+
+var file_kvb_v1_departures_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_kvb_v1_departures_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_kvb_v1_departures_proto_goTypes = []interface{}{
+	(LineMode)(0),                      // 0: kvb.v1.LineMode
+	(*GetDeparturesRequest)(nil),       // 1: kvb.v1.GetDeparturesRequest
+	(*GetDeparturesResponse)(nil),      // 2: kvb.v1.GetDeparturesResponse
+	(*BatchGetDeparturesRequest)(nil),  // 3: kvb.v1.BatchGetDeparturesRequest
+	(*BatchGetDeparturesResponse)(nil), // 4: kvb.v1.BatchGetDeparturesResponse
+	(*BatchGetDeparturesResult)(nil),   // 5: kvb.v1.BatchGetDeparturesResult
+	(*SearchStationsRequest)(nil),      // 6: kvb.v1.SearchStationsRequest
+	(*SearchStationsResponse)(nil),     // 7: kvb.v1.SearchStationsResponse
+	(*WatchDeparturesRequest)(nil),     // 8: kvb.v1.WatchDeparturesRequest
+	(*WatchDeparturesResponse)(nil),    // 9: kvb.v1.WatchDeparturesResponse
+	(*Departures)(nil),                 // 10: kvb.v1.Departures
+	(*Departure)(nil),                  // 11: kvb.v1.Departure
+	(*Line)(nil),                       // 12: kvb.v1.Line
+	(*Station)(nil),                    // 13: kvb.v1.Station
+	(*StationDetails)(nil),             // 14: kvb.v1.StationDetails
+	(*durationpb.Duration)(nil),        // 15: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 16: google.protobuf.Timestamp
+}
+var file_kvb_v1_departures_proto_depIdxs = []int32{
+	10, // 0: kvb.v1.GetDeparturesResponse.departures:type_name -> kvb.v1.Departures
+	1,  // 1: kvb.v1.BatchGetDeparturesRequest.requests:type_name -> kvb.v1.GetDeparturesRequest
+	5,  // 2: kvb.v1.BatchGetDeparturesResponse.results:type_name -> kvb.v1.BatchGetDeparturesResult
+	10, // 3: kvb.v1.BatchGetDeparturesResult.departures:type_name -> kvb.v1.Departures
+	13, // 4: kvb.v1.SearchStationsResponse.stations:type_name -> kvb.v1.Station
+	1,  // 5: kvb.v1.WatchDeparturesRequest.request:type_name -> kvb.v1.GetDeparturesRequest
+	15, // 6: kvb.v1.WatchDeparturesRequest.interval:type_name -> google.protobuf.Duration
+	10, // 7: kvb.v1.WatchDeparturesResponse.departures:type_name -> kvb.v1.Departures
+	11, // 8: kvb.v1.Departures.departures:type_name -> kvb.v1.Departure
+	16, // 9: kvb.v1.Departures.fetched_at:type_name -> google.protobuf.Timestamp
+	12, // 10: kvb.v1.Departure.line_details:type_name -> kvb.v1.Line
+	16, // 11: kvb.v1.Departure.planned_time:type_name -> google.protobuf.Timestamp
+	0,  // 12: kvb.v1.Line.mode:type_name -> kvb.v1.LineMode
+	14, // 13: kvb.v1.Station.details:type_name -> kvb.v1.StationDetails
+	1,  // 14: kvb.v1.DepartureService.GetDepartures:input_type -> kvb.v1.GetDeparturesRequest
+	3,  // 15: kvb.v1.DepartureService.BatchGetDepartures:input_type -> kvb.v1.BatchGetDeparturesRequest
+	6,  // 16: kvb.v1.DepartureService.SearchStations:input_type -> kvb.v1.SearchStationsRequest
+	8,  // 17: kvb.v1.DepartureService.WatchDepartures:input_type -> kvb.v1.WatchDeparturesRequest
+	2,  // 18: kvb.v1.DepartureService.GetDepartures:output_type -> kvb.v1.GetDeparturesResponse
+	4,  // 19: kvb.v1.DepartureService.BatchGetDepartures:output_type -> kvb.v1.BatchGetDeparturesResponse
+	7,  // 20: kvb.v1.DepartureService.SearchStations:output_type -> kvb.v1.SearchStationsResponse
+	9,  // 21: kvb.v1.DepartureService.WatchDepartures:output_type -> kvb.v1.WatchDeparturesResponse
+	18, // [18:22] is the sub-list for method output_type
+	14, // [14:18] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_kvb_v1_departures_proto_init() }
+func file_kvb_v1_departures_proto_init() {
+	if File_kvb_v1_departures_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kvb_v1_departures_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeparturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeparturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetDeparturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetDeparturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetDeparturesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDeparturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDeparturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Departures); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Departure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Line); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Station); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kvb_v1_departures_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_kvb_v1_departures_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*GetDeparturesRequest_StationId)(nil),
+		(*GetDeparturesRequest_StationName)(nil),
+		(*GetDeparturesRequest_StationRef)(nil),
+	}
+	file_kvb_v1_departures_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*BatchGetDeparturesResult_Departures)(nil),
+		(*BatchGetDeparturesResult_Error)(nil),
+	}
+	file_kvb_v1_departures_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_kvb_v1_departures_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kvb_v1_departures_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kvb_v1_departures_proto_goTypes,
+		DependencyIndexes: file_kvb_v1_departures_proto_depIdxs,
+		EnumInfos:         file_kvb_v1_departures_proto_enumTypes,
+		MessageInfos:      file_kvb_v1_departures_proto_msgTypes,
+	}.Build()
+	File_kvb_v1_departures_proto = out.File
+	file_kvb_v1_departures_proto_rawDesc = nil
+	file_kvb_v1_departures_proto_goTypes = nil
+	file_kvb_v1_departures_proto_depIdxs = nil
+}
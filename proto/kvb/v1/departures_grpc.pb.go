@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: kvb/v1/departures.proto
+
+package kvbv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DepartureServiceClient is the client API for DepartureService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DepartureServiceClient interface {
+	// GetDepartures returns the departures of a station
+	GetDepartures(ctx context.Context, in *GetDeparturesRequest, opts ...grpc.CallOption) (*GetDeparturesResponse, error)
+	// BatchGetDepartures returns the departures of up to 50 stations, fetched concurrently. A failing station doesn't fail
+	// the batch, its result carries the error instead.
+	BatchGetDepartures(ctx context.Context, in *BatchGetDeparturesRequest, opts ...grpc.CallOption) (*BatchGetDeparturesResponse, error)
+	// SearchStations returns the stations of all providers best matching the query
+	SearchStations(ctx context.Context, in *SearchStationsRequest, opts ...grpc.CallOption) (*SearchStationsResponse, error)
+	// WatchDepartures streams the departures of a station, a message is sent whenever fresh departures were fetched
+	WatchDepartures(ctx context.Context, in *WatchDeparturesRequest, opts ...grpc.CallOption) (DepartureService_WatchDeparturesClient, error)
+}
+
+type departureServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDepartureServiceClient(cc grpc.ClientConnInterface) DepartureServiceClient {
+	return &departureServiceClient{cc}
+}
+
+func (c *departureServiceClient) GetDepartures(ctx context.Context, in *GetDeparturesRequest, opts ...grpc.CallOption) (*GetDeparturesResponse, error) {
+	out := new(GetDeparturesResponse)
+	err := c.cc.Invoke(ctx, "/kvb.v1.DepartureService/GetDepartures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departureServiceClient) BatchGetDepartures(ctx context.Context, in *BatchGetDeparturesRequest, opts ...grpc.CallOption) (*BatchGetDeparturesResponse, error) {
+	out := new(BatchGetDeparturesResponse)
+	err := c.cc.Invoke(ctx, "/kvb.v1.DepartureService/BatchGetDepartures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departureServiceClient) SearchStations(ctx context.Context, in *SearchStationsRequest, opts ...grpc.CallOption) (*SearchStationsResponse, error) {
+	out := new(SearchStationsResponse)
+	err := c.cc.Invoke(ctx, "/kvb.v1.DepartureService/SearchStations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departureServiceClient) WatchDepartures(ctx context.Context, in *WatchDeparturesRequest, opts ...grpc.CallOption) (DepartureService_WatchDeparturesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DepartureService_ServiceDesc.Streams[0], "/kvb.v1.DepartureService/WatchDepartures", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &departureServiceWatchDeparturesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DepartureService_WatchDeparturesClient interface {
+	Recv() (*WatchDeparturesResponse, error)
+	grpc.ClientStream
+}
+
+type departureServiceWatchDeparturesClient struct {
+	grpc.ClientStream
+}
+
+func (x *departureServiceWatchDeparturesClient) Recv() (*WatchDeparturesResponse, error) {
+	m := new(WatchDeparturesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DepartureServiceServer is the server API for DepartureService service.
+// All implementations must embed UnimplementedDepartureServiceServer
+// for forward compatibility
+type DepartureServiceServer interface {
+	// GetDepartures returns the departures of a station
+	GetDepartures(context.Context, *GetDeparturesRequest) (*GetDeparturesResponse, error)
+	// BatchGetDepartures returns the departures of up to 50 stations, fetched concurrently. A failing station doesn't fail
+	// the batch, its result carries the error instead.
+	BatchGetDepartures(context.Context, *BatchGetDeparturesRequest) (*BatchGetDeparturesResponse, error)
+	// SearchStations returns the stations of all providers best matching the query
+	SearchStations(context.Context, *SearchStationsRequest) (*SearchStationsResponse, error)
+	// WatchDepartures streams the departures of a station, a message is sent whenever fresh departures were fetched
+	WatchDepartures(*WatchDeparturesRequest, DepartureService_WatchDeparturesServer) error
+	mustEmbedUnimplementedDepartureServiceServer()
+}
+
+// UnimplementedDepartureServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDepartureServiceServer struct {
+}
+
+func (UnimplementedDepartureServiceServer) GetDepartures(context.Context, *GetDeparturesRequest) (*GetDeparturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartures not implemented")
+}
+func (UnimplementedDepartureServiceServer) BatchGetDepartures(context.Context, *BatchGetDeparturesRequest) (*BatchGetDeparturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetDepartures not implemented")
+}
+func (UnimplementedDepartureServiceServer) SearchStations(context.Context, *SearchStationsRequest) (*SearchStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStations not implemented")
+}
+func (UnimplementedDepartureServiceServer) WatchDepartures(*WatchDeparturesRequest, DepartureService_WatchDeparturesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDepartures not implemented")
+}
+func (UnimplementedDepartureServiceServer) mustEmbedUnimplementedDepartureServiceServer() {}
+
+// UnsafeDepartureServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DepartureServiceServer will
+// result in compilation errors.
+type UnsafeDepartureServiceServer interface {
+	mustEmbedUnimplementedDepartureServiceServer()
+}
+
+func RegisterDepartureServiceServer(s grpc.ServiceRegistrar, srv DepartureServiceServer) {
+	s.RegisterService(&DepartureService_ServiceDesc, srv)
+}
+
+func _DepartureService_GetDepartures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeparturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartureServiceServer).GetDepartures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvb.v1.DepartureService/GetDepartures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartureServiceServer).GetDepartures(ctx, req.(*GetDeparturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartureService_BatchGetDepartures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetDeparturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartureServiceServer).BatchGetDepartures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvb.v1.DepartureService/BatchGetDepartures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartureServiceServer).BatchGetDepartures(ctx, req.(*BatchGetDeparturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartureService_SearchStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartureServiceServer).SearchStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvb.v1.DepartureService/SearchStations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartureServiceServer).SearchStations(ctx, req.(*SearchStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartureService_WatchDepartures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeparturesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DepartureServiceServer).WatchDepartures(m, &departureServiceWatchDeparturesServer{stream})
+}
+
+type DepartureService_WatchDeparturesServer interface {
+	Send(*WatchDeparturesResponse) error
+	grpc.ServerStream
+}
+
+type departureServiceWatchDeparturesServer struct {
+	grpc.ServerStream
+}
+
+func (x *departureServiceWatchDeparturesServer) Send(m *WatchDeparturesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// DepartureService_ServiceDesc is the grpc.ServiceDesc for DepartureService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DepartureService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kvb.v1.DepartureService",
+	HandlerType: (*DepartureServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDepartures",
+			Handler:    _DepartureService_GetDepartures_Handler,
+		},
+		{
+			MethodName: "BatchGetDepartures",
+			Handler:    _DepartureService_BatchGetDepartures_Handler,
+		},
+		{
+			MethodName: "SearchStations",
+			Handler:    _DepartureService_SearchStations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDepartures",
+			Handler:       _DepartureService_WatchDepartures_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kvb/v1/departures.proto",
+}
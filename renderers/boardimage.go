@@ -0,0 +1,385 @@
+package renderers
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/janritter/kvb-api/domains"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+type ColorDepth string
+
+const (
+	Monochrome ColorDepth = "1bit"
+	Grayscale  ColorDepth = "gray"
+	Color      ColorDepth = "color"
+)
+
+// BoardImageOptions describes the size and look of a rendered departure board
+type BoardImageOptions struct {
+	Width    int
+	Height   int
+	Depth    ColorDepth
+	FontSize int
+	// Rows is the maximum number of departures drawn, zero fills the available height
+	Rows int
+	// Unavailable draws a notice instead of the departures, for departures that couldn't be loaded
+	Unavailable bool
+}
+
+// unavailableNotice replaces the departures on boards whose departures couldn't be loaded
+const unavailableNotice = "Abfahrten nicht verfügbar"
+
+// boardPalette holds the colors of a board for one color depth
+type boardPalette struct {
+	background color.Color
+	text       color.Color
+	muted      color.Color
+	badge      color.Color
+	badgeText  color.Color
+	accent     color.Color
+	// lineColors draws badges in the official color of the line instead of the badge color
+	lineColors bool
+}
+
+var boardPalettes = map[ColorDepth]boardPalette{
+	Monochrome: {
+		background: color.White,
+		text:       color.Black,
+		muted:      color.Black,
+		badge:      color.Black,
+		badgeText:  color.White,
+		accent:     color.Black,
+	},
+	Grayscale: {
+		background: color.White,
+		text:       color.Black,
+		muted:      color.Gray{Y: 0x70},
+		badge:      color.Gray{Y: 0x30},
+		badgeText:  color.White,
+		accent:     color.Gray{Y: 0x30},
+	},
+	Color: {
+		background: color.White,
+		text:       color.Black,
+		muted:      color.RGBA{R: 0x70, G: 0x70, B: 0x70, A: 0xff},
+		badge:      color.RGBA{R: 0xe3, G: 0x00, B: 0x1b, A: 0xff},
+		badgeText:  color.White,
+		accent:     color.RGBA{R: 0xe3, G: 0x00, B: 0x1b, A: 0xff},
+		lineColors: true,
+	},
+}
+
+// boardLayout contains the positions shared by the PNG and SVG renderer, so both look alike
+type boardLayout struct {
+	padding     int
+	headerSize  int
+	headerLine  int
+	rowHeight   int
+	firstRow    int
+	badgeWidth  int
+	badgeHeight int
+	textX       int
+	rows        int
+	// noticeBaseline is the baseline of the notice of unavailable boards, in the middle of the first row
+	noticeBaseline int
+}
+
+func newBoardLayout(options BoardImageOptions, departures int) boardLayout {
+	layout := boardLayout{
+		padding:     options.FontSize / 2,
+		headerSize:  options.FontSize * 5 / 4,
+		rowHeight:   options.FontSize * 9 / 5,
+		badgeWidth:  options.FontSize * 3,
+		badgeHeight: options.FontSize * 7 / 5,
+	}
+	layout.headerLine = layout.padding + layout.headerSize*3/2
+	layout.firstRow = layout.headerLine + layout.padding
+	layout.textX = layout.padding + layout.badgeWidth + options.FontSize/2
+	layout.noticeBaseline = layout.firstRow + (layout.rowHeight+options.FontSize*7/10)/2
+
+	layout.rows = (options.Height - layout.firstRow - layout.padding) / layout.rowHeight
+	if options.Rows > 0 && options.Rows < layout.rows {
+		layout.rows = options.Rows
+	}
+	if departures < layout.rows {
+		layout.rows = departures
+	}
+	if options.Unavailable {
+		layout.rows = 0
+	}
+	if layout.rows < 0 {
+		layout.rows = 0
+	}
+
+	return layout
+}
+
+// badgeColors returns the background and text color of the line badge of a departure
+func badgeColors(palette boardPalette, departure domains.Departure) (color.Color, color.Color) {
+	if !palette.lineColors || departure.LineDetails == nil {
+		return palette.badge, palette.badgeText
+	}
+
+	background, backgroundOK := parseHexColor(departure.LineDetails.Color)
+	text, textOK := parseHexColor(departure.LineDetails.TextColor)
+	if !backgroundOK || !textOK {
+		return palette.badge, palette.badgeText
+	}
+	return background, text
+}
+
+// parseHexColor parses colors in the #RRGGBB notation
+func parseHexColor(hex string) (color.RGBA, bool) {
+	var r, g, b uint8
+	if len(hex) != 7 {
+		return color.RGBA{}, false
+	}
+	if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); err != nil {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{R: r, G: g, B: b, A: 0xff}, true
+}
+
+func minutesLabel(departure domains.Departure) string {
+	switch {
+	case !departure.ArrivalKnown():
+		return "?"
+	case departure.ArrivalInMinutes == 0:
+		return "Sofort"
+	}
+	return strconv.Itoa(departure.ArrivalInMinutes) + " Min"
+}
+
+// statusLabel is shown right of the station name, it only depends on the departures so equal departures render equal images
+func statusLabel(departures domains.Departures) string {
+	if departures.FetchedAt.IsZero() {
+		return ""
+	}
+
+	label := "Stand " + departures.FetchedAt.In(domains.Location).Format("15:04")
+	if departures.Stale {
+		label += " (veraltet)"
+	}
+	return label
+}
+
+var (
+	// faceCacheMu only guards the cache, the faces are pooled because they aren't safe for concurrent use
+	faceCacheMu sync.Mutex
+	faceCache   = map[faceKey]*sync.Pool{}
+
+	regularFont = mustParseFont(goregular.TTF)
+	boldFont    = mustParseFont(gobold.TTF)
+)
+
+type faceKey struct {
+	bold bool
+	size int
+}
+
+func mustParseFont(ttf []byte) *opentype.Font {
+	parsed, err := opentype.Parse(ttf)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func newFace(key faceKey) (font.Face, error) {
+	parsed := regularFont
+	if key.bold {
+		parsed = boldFont
+	}
+	return opentype.NewFace(parsed, &opentype.FaceOptions{Size: float64(key.size), DPI: 72, Hinting: font.HintingFull})
+}
+
+// acquireFace takes a face of the font from the cache, it has to be handed back with releaseFace after drawing
+func acquireFace(bold bool, size int) (font.Face, error) {
+	key := faceKey{bold: bold, size: size}
+
+	faceCacheMu.Lock()
+	pool, found := faceCache[key]
+	if !found {
+		pool = &sync.Pool{}
+		faceCache[key] = pool
+	}
+	faceCacheMu.Unlock()
+
+	if face, ok := pool.Get().(font.Face); ok {
+		return face, nil
+	}
+	return newFace(key)
+}
+
+func releaseFace(bold bool, size int, face font.Face) {
+	faceCacheMu.Lock()
+	pool := faceCache[faceKey{bold: bold, size: size}]
+	faceCacheMu.Unlock()
+
+	pool.Put(face)
+}
+
+// RenderBoardPNG draws the departures as board image and encodes it as PNG in the requested color depth
+func RenderBoardPNG(w io.Writer, departures domains.Departures, options BoardImageOptions) error {
+	palette, found := boardPalettes[options.Depth]
+	if !found {
+		return fmt.Errorf("unknown color depth %q", options.Depth)
+	}
+
+	canvas, err := drawBoard(departures, options, palette)
+	if err != nil {
+		return err
+	}
+
+	switch options.Depth {
+	case Monochrome:
+		return png.Encode(w, threshold(canvas))
+	case Grayscale:
+		gray := image.NewGray(canvas.Bounds())
+		draw.Draw(gray, gray.Bounds(), canvas, image.Point{}, draw.Src)
+		return png.Encode(w, gray)
+	default:
+		return png.Encode(w, canvas)
+	}
+}
+
+func drawBoard(departures domains.Departures, options BoardImageOptions, palette boardPalette) (*image.RGBA, error) {
+	layout := newBoardLayout(options, len(departures.Departures))
+
+	regular, err := acquireFace(false, options.FontSize)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFace(false, options.FontSize, regular)
+	bold, err := acquireFace(true, options.FontSize)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFace(true, options.FontSize, bold)
+	header, err := acquireFace(true, layout.headerSize)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseFace(true, layout.headerSize, header)
+
+	canvas := image.NewRGBA(image.Rect(0, 0, options.Width, options.Height))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(palette.background), image.Point{}, draw.Src)
+
+	// Header with station name and fetch time
+	status := statusLabel(departures)
+	statusWidth := font.MeasureString(regular, status).Ceil()
+	headerBaseline := layout.padding + layout.headerSize
+	drawText(canvas, header, palette.text, layout.padding, headerBaseline, departures.Station, options.Width-3*layout.padding-statusWidth)
+	drawText(canvas, regular, palette.muted, options.Width-layout.padding-statusWidth, headerBaseline, status, statusWidth)
+	fillRect(canvas, image.Rect(layout.padding, layout.headerLine, options.Width-layout.padding, layout.headerLine+max(2, options.FontSize/8)), palette.accent)
+
+	for i := 0; i < layout.rows; i++ {
+		departure := departures.Departures[i]
+		top := layout.firstRow + i*layout.rowHeight
+		baseline := top + (layout.rowHeight+options.FontSize*7/10)/2
+
+		// Line badge with the line centered in it
+		badgeTop := top + (layout.rowHeight-layout.badgeHeight)/2
+		badge, badgeText := badgeColors(palette, departure)
+		fillRoundedRect(canvas, layout.padding, badgeTop, layout.badgeWidth, layout.badgeHeight, layout.badgeHeight/5, badge)
+		lineWidth := font.MeasureString(bold, departure.Line).Ceil()
+		drawText(canvas, bold, badgeText, layout.padding+(layout.badgeWidth-lineWidth)/2, baseline, departure.Line, layout.badgeWidth)
+
+		minutes := minutesLabel(departure)
+		minutesWidth := font.MeasureString(bold, minutes).Ceil()
+		drawText(canvas, bold, palette.text, options.Width-layout.padding-minutesWidth, baseline, minutes, minutesWidth)
+		drawText(canvas, regular, palette.text, layout.textX, baseline, departure.Destination, options.Width-layout.textX-minutesWidth-2*layout.padding)
+	}
+
+	if options.Unavailable {
+		drawText(canvas, bold, palette.text, layout.padding, layout.noticeBaseline, unavailableNotice, options.Width-2*layout.padding)
+	}
+
+	return canvas, nil
+}
+
+// drawText draws text at the baseline, shortening it with an ellipsis to fit maxWidth
+func drawText(dst draw.Image, face font.Face, c color.Color, x int, baseline int, text string, maxWidth int) {
+	text = fitText(text, maxWidth, func(s string) int {
+		return font.MeasureString(face, s).Ceil()
+	})
+
+	drawer := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, baseline),
+	}
+	drawer.DrawString(text)
+}
+
+// fitText shortens text with an ellipsis until its measured width fits maxWidth
+func fitText(text string, maxWidth int, measure func(string) int) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if measure(text) <= maxWidth {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := string(runes) + "…"
+		if measure(candidate) <= maxWidth {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func fillRect(dst draw.Image, rect image.Rectangle, c color.Color) {
+	draw.Draw(dst, rect, image.NewUniform(c), image.Point{}, draw.Over)
+}
+
+func fillRoundedRect(dst draw.Image, x, y, width, height, radius int, c color.Color) {
+	rasterizer := vector.NewRasterizer(width, height)
+	w, h, r := float32(width), float32(height), float32(radius)
+
+	rasterizer.MoveTo(r, 0)
+	rasterizer.LineTo(w-r, 0)
+	rasterizer.QuadTo(w, 0, w, r)
+	rasterizer.LineTo(w, h-r)
+	rasterizer.QuadTo(w, h, w-r, h)
+	rasterizer.LineTo(r, h)
+	rasterizer.QuadTo(0, h, 0, h-r)
+	rasterizer.LineTo(0, r)
+	rasterizer.QuadTo(0, 0, r, 0)
+	rasterizer.ClosePath()
+
+	rasterizer.Draw(dst, image.Rect(x, y, x+width, y+height), image.NewUniform(c), image.Point{})
+}
+
+// threshold converts to a two color image, a palette of two colors is encoded as 1 bit PNG
+func threshold(src image.Image) *image.Paletted {
+	bounds := src.Bounds()
+	dst := image.NewPaletted(bounds, color.Palette{color.Black, color.White})
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if color.GrayModel.Convert(src.At(x, y)).(color.Gray).Y >= 0x80 {
+				dst.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+
+	return dst
+}
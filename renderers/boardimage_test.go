@@ -0,0 +1,137 @@
+package renderers
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+var boardDepartures = domains.Departures{
+	Station: "Neumarkt",
+	Departures: []domains.Departure{
+		{Line: "1", Destination: "Weiden West", ArrivalInMinutes: 0, LineDetails: &domains.Line{Name: "1", Color: "#ed1c24", TextColor: "#ffffff"}},
+		{Line: "9", Destination: "Königsforst", ArrivalInMinutes: 4, LineDetails: &domains.Line{Name: "9", Color: "#f5a2c4", TextColor: "#000000"}},
+		{Line: "136", Destination: "Hauptbahnhof über eine sehr lange Umleitung", ArrivalInMinutes: 12},
+	},
+	Stale:     true,
+	FetchedAt: time.Date(2024, 3, 1, 8, 15, 30, 0, time.UTC),
+}
+
+var boardOptions = BoardImageOptions{Width: 400, Height: 200, FontSize: 16}
+
+// assertGolden compares got with the golden file, running the tests with -update rewrites it
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from the golden file, run the tests with -update if the change is intended", name)
+	}
+}
+
+func TestRenderBoardPNG(t *testing.T) {
+	for _, depth := range []ColorDepth{Monochrome, Grayscale, Color} {
+		t.Run(string(depth), func(t *testing.T) {
+			options := boardOptions
+			options.Depth = depth
+
+			buf := &bytes.Buffer{}
+			if err := RenderBoardPNG(buf, boardDepartures, options); err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, "board-"+string(depth)+".png", buf.Bytes())
+		})
+	}
+}
+
+func TestRenderBoardSVG(t *testing.T) {
+	for _, depth := range []ColorDepth{Monochrome, Grayscale, Color} {
+		t.Run(string(depth), func(t *testing.T) {
+			options := boardOptions
+			options.Depth = depth
+
+			buf := &bytes.Buffer{}
+			if err := RenderBoardSVG(buf, boardDepartures, options); err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, "board-"+string(depth)+".svg", buf.Bytes())
+		})
+	}
+}
+
+func TestRenderBoardUnavailable(t *testing.T) {
+	options := boardOptions
+	options.Depth = Color
+	options.Unavailable = true
+	departures := domains.Departures{Station: "Neumarkt"}
+
+	png := &bytes.Buffer{}
+	if err := RenderBoardPNG(png, departures, options); err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "board-unavailable.png", png.Bytes())
+
+	svg := &bytes.Buffer{}
+	if err := RenderBoardSVG(svg, departures, options); err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "board-unavailable.svg", svg.Bytes())
+}
+
+func TestRenderBoardPNGConcurrently(t *testing.T) {
+	options := boardOptions
+	options.Depth = Color
+
+	want := &bytes.Buffer{}
+	if err := RenderBoardPNG(want, boardDepartures, options); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			got := &bytes.Buffer{}
+			if err := RenderBoardPNG(got, boardDepartures, options); err != nil {
+				t.Error(err)
+				return
+			}
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Error("concurrent renders differ")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRenderBoardUnknownDepth(t *testing.T) {
+	options := boardOptions
+	options.Depth = "sepia"
+
+	if err := RenderBoardPNG(&bytes.Buffer{}, boardDepartures, options); err == nil {
+		t.Error("expected an error for an unknown color depth")
+	}
+	if err := RenderBoardSVG(&bytes.Buffer{}, boardDepartures, options); err == nil {
+		t.Error("expected an error for an unknown color depth")
+	}
+}
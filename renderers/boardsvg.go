@@ -0,0 +1,87 @@
+package renderers
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"unicode/utf8"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// RenderBoardSVG writes the departures as SVG board with the same layout as RenderBoardPNG
+func RenderBoardSVG(w io.Writer, departures domains.Departures, options BoardImageOptions) error {
+	palette, found := boardPalettes[options.Depth]
+	if !found {
+		return fmt.Errorf("unknown color depth %q", options.Depth)
+	}
+
+	layout := newBoardLayout(options, len(departures.Departures))
+	// SVG text isn't measured, average glyph widths of sans-serif fonts are used to shorten texts instead
+	charWidth := func(size int) int { return max(1, size*11/20) }
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif" font-size="%d">`+"\n",
+		options.Width, options.Height, options.Width, options.Height, options.FontSize)
+	fmt.Fprintf(buf, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", hexColor(palette.background))
+
+	status := statusLabel(departures)
+	headerBaseline := layout.padding + layout.headerSize
+	fmt.Fprintf(buf, `<text x="%d" y="%d" font-size="%d" font-weight="bold" fill="%s">%s</text>`+"\n",
+		layout.padding, headerBaseline, layout.headerSize, hexColor(palette.text),
+		escape(fitChars(departures.Station, (options.Width-3*layout.padding)/charWidth(layout.headerSize)-utf8.RuneCountInString(status))))
+	fmt.Fprintf(buf, `<text x="%d" y="%d" text-anchor="end" fill="%s">%s</text>`+"\n",
+		options.Width-layout.padding, headerBaseline, hexColor(palette.muted), escape(status))
+	fmt.Fprintf(buf, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n",
+		layout.padding, layout.headerLine, options.Width-2*layout.padding, max(2, options.FontSize/8), hexColor(palette.accent))
+
+	for i := 0; i < layout.rows; i++ {
+		departure := departures.Departures[i]
+		top := layout.firstRow + i*layout.rowHeight
+		baseline := top + (layout.rowHeight+options.FontSize*7/10)/2
+		badgeTop := top + (layout.rowHeight-layout.badgeHeight)/2
+		minutes := minutesLabel(departure)
+		badge, badgeText := badgeColors(palette, departure)
+
+		fmt.Fprintf(buf, `<g>`)
+		fmt.Fprintf(buf, `<rect x="%d" y="%d" width="%d" height="%d" rx="%d" fill="%s"/>`,
+			layout.padding, badgeTop, layout.badgeWidth, layout.badgeHeight, layout.badgeHeight/5, hexColor(badge))
+		fmt.Fprintf(buf, `<text x="%d" y="%d" text-anchor="middle" font-weight="bold" fill="%s">%s</text>`,
+			layout.padding+layout.badgeWidth/2, baseline, hexColor(badgeText), escape(departure.Line))
+		fmt.Fprintf(buf, `<text x="%d" y="%d" fill="%s">%s</text>`,
+			layout.textX, baseline, hexColor(palette.text),
+			escape(fitChars(departure.Destination, (options.Width-layout.textX-2*layout.padding)/charWidth(options.FontSize)-utf8.RuneCountInString(minutes))))
+		fmt.Fprintf(buf, `<text x="%d" y="%d" text-anchor="end" font-weight="bold" fill="%s">%s</text>`,
+			options.Width-layout.padding, baseline, hexColor(palette.text), escape(minutes))
+		fmt.Fprintf(buf, "</g>\n")
+	}
+
+	if options.Unavailable {
+		fmt.Fprintf(buf, `<text x="%d" y="%d" font-weight="bold" fill="%s">%s</text>`+"\n",
+			layout.padding, layout.noticeBaseline, hexColor(palette.text),
+			escape(fitChars(unavailableNotice, (options.Width-2*layout.padding)/charWidth(options.FontSize))))
+	}
+
+	fmt.Fprintf(buf, "</svg>\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func fitChars(text string, maxChars int) string {
+	return fitText(text, maxChars, utf8.RuneCountInString)
+}
+
+func hexColor(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
+
+func escape(text string) string {
+	buf := &bytes.Buffer{}
+	xml.EscapeText(buf, []byte(text))
+	return buf.String()
+}
@@ -0,0 +1,23 @@
+package renderers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+// departureIDs builds IDs for departures, which don't have IDs upstream, from their line, destination and estimated
+// departure minute, so a departure keeps its ID between feeds unless its estimate changes
+type departureIDs map[string]int
+
+// next returns the ID of the departure, departures of a line to a destination in the same minute are told apart by a counter
+func (ids departureIDs) next(prefix string, departure domains.Departure, departureTime time.Time) string {
+	id := fmt.Sprintf("%s-%s-%s-%s", prefix, domains.Slug(departure.Line), domains.Slug(departure.Destination), departureTime.UTC().Format("20060102T1504"))
+
+	ids[id]++
+	if ids[id] > 1 {
+		id += fmt.Sprintf("-%d", ids[id])
+	}
+	return id
+}
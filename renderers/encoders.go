@@ -0,0 +1,112 @@
+package renderers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"unicode/utf8"
+)
+
+type JSON struct{}
+
+func (JSON) Format() string    { return "json" }
+func (JSON) MediaType() string { return "application/json" }
+
+func (JSON) Encode(buf *bytes.Buffer, v any) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(payload)
+	return nil
+}
+
+type XML struct{}
+
+func (XML) Format() string    { return "xml" }
+func (XML) MediaType() string { return "application/xml; charset=utf-8" }
+
+func (XML) Encode(buf *bytes.Buffer, v any) error {
+	buf.WriteString(xml.Header)
+	return xml.NewEncoder(buf).Encode(v)
+}
+
+type CSV struct{}
+
+func (CSV) Format() string    { return "csv" }
+func (CSV) MediaType() string { return "text/csv; charset=utf-8" }
+
+func (CSV) Encode(buf *bytes.Buffer, v any) error {
+	tabular, ok := v.(Tabular)
+	if !ok {
+		return ErrNotTabular
+	}
+
+	header, rows := tabular.Table()
+	writer := csv.NewWriter(buf)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// Text renders a fixed-width table, columns are as wide as their longest value up to maxColumnWidth
+type Text struct{}
+
+const maxColumnWidth = 40
+
+func (Text) Format() string    { return "text" }
+func (Text) MediaType() string { return "text/plain; charset=utf-8" }
+
+func (Text) Encode(buf *bytes.Buffer, v any) error {
+	tabular, ok := v.(Tabular)
+	if !ok {
+		return ErrNotTabular
+	}
+
+	header, rows := tabular.Table()
+
+	widths := make([]int, len(header))
+	for _, row := range append([][]string{header}, rows...) {
+		for i, value := range row {
+			if i < len(widths) && utf8.RuneCountInString(value) > widths[i] {
+				widths[i] = min(utf8.RuneCountInString(value), maxColumnWidth)
+			}
+		}
+	}
+
+	writeRow := func(row []string) {
+		cells := make([]string, len(row))
+		for i, value := range row {
+			cells[i] = pad(value, widths[i])
+		}
+		buf.WriteString(strings.TrimRight(strings.Join(cells, "  "), " "))
+		buf.WriteString("\n")
+	}
+
+	writeRow(header)
+	separators := make([]string, len(header))
+	for i := range separators {
+		separators[i] = strings.Repeat("-", widths[i])
+	}
+	writeRow(separators)
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	return nil
+}
+
+// pad truncates or pads value to exactly width runes
+func pad(value string, width int) string {
+	runes := []rune(value)
+	if len(runes) > width {
+		return string(runes[:width-1]) + "…"
+	}
+	return value + strings.Repeat(" ", width-len(runes))
+}
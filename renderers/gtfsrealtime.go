@@ -0,0 +1,67 @@
+package renderers
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MobilityData/gtfs-realtime-bindings/golang/gtfs"
+	"github.com/janritter/kvb-api/domains"
+	"google.golang.org/protobuf/proto"
+)
+
+// BuildTripUpdates converts departures by KVB station ID into a GTFS-Realtime feed with one TripUpdate per departure.
+// KVB doesn't publish trips, so the feed is route-level only: trips are described by their route and service date
+// without trip_id, consumers can't match them to the trips of the static GTFS. Entity IDs are built from the station,
+// line, destination and estimated departure minute like the calendar UIDs, so consumers can follow them.
+func BuildTripUpdates(departuresByStation map[int]domains.Departures, mapping domains.GTFSMapping, now time.Time) *gtfs.FeedMessage {
+	feed := &gtfs.FeedMessage{
+		Header: &gtfs.FeedHeader{
+			GtfsRealtimeVersion: proto.String("2.0"),
+			Incrementality:      gtfs.FeedHeader_FULL_DATASET.Enum(),
+			Timestamp:           proto.Uint64(uint64(now.Unix())),
+		},
+	}
+
+	stationIDs := make([]int, 0, len(departuresByStation))
+	for stationID := range departuresByStation {
+		stationIDs = append(stationIDs, stationID)
+	}
+	sort.Ints(stationIDs)
+
+	for _, stationID := range stationIDs {
+		departures := departuresByStation[stationID]
+		base := departures.FetchedAt.Truncate(time.Minute)
+		stopID := mapping.StopID(stationID)
+
+		ids := departureIDs{}
+		for _, departure := range departures.Departures {
+			if departure.ArrivalInMinutes < 0 {
+				continue
+			}
+
+			departureTime := base.Add(time.Duration(departure.ArrivalInMinutes) * time.Minute)
+			event := &gtfs.TripUpdate_StopTimeEvent{Time: proto.Int64(departureTime.Unix())}
+
+			feed.Entity = append(feed.Entity, &gtfs.FeedEntity{
+				Id: proto.String(ids.next(strconv.Itoa(stationID), departure, departureTime)),
+				TripUpdate: &gtfs.TripUpdate{
+					Trip: &gtfs.TripDescriptor{
+						RouteId:   proto.String(mapping.RouteID(departure.Line)),
+						StartDate: proto.String(departureTime.In(domains.Location).Format("20060102")),
+					},
+					StopTimeUpdate: []*gtfs.TripUpdate_StopTimeUpdate{
+						{
+							StopId:    proto.String(stopID),
+							Arrival:   event,
+							Departure: event,
+						},
+					},
+					Timestamp: proto.Uint64(uint64(departures.FetchedAt.Unix())),
+				},
+			})
+		}
+	}
+
+	return feed
+}
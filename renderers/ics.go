@@ -0,0 +1,98 @@
+package renderers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/janritter/kvb-api/domains"
+)
+
+const (
+	icsTimeFormat = "20060102T150405Z"
+	// icsEventDuration is the length of the calendar entry of a departure
+	icsEventDuration = time.Minute
+	icsLineLength    = 75
+)
+
+// RenderICS writes the departures as RFC 5545 calendar with one event per departure.
+// Departures don't have IDs, so the UID of an event is the line, destination and estimated departure minute: refreshes
+// of the feed keep the UID of a departure and calendar clients update the event instead of adding a new one.
+func RenderICS(w io.Writer, departures domains.Departures, refresh time.Duration) error {
+	buf := &bytes.Buffer{}
+	writeLine := func(format string, args ...any) {
+		writeFolded(buf, fmt.Sprintf(format, args...))
+	}
+
+	stamp := departures.FetchedAt.UTC()
+	// The departure time is only known to the minute, it is the fetch time plus the countdown
+	base := departures.FetchedAt.Truncate(time.Minute)
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//janritter//kvb-api//DE")
+	writeLine("CALSCALE:GREGORIAN")
+	writeLine("METHOD:PUBLISH")
+	writeLine("X-WR-CALNAME:%s", escapeICSText("KVB "+departures.Station))
+	writeLine("REFRESH-INTERVAL;VALUE=DURATION:%s", icsDuration(refresh))
+	writeLine("X-PUBLISHED-TTL:%s", icsDuration(refresh))
+
+	sorted := append([]domains.Departure{}, departures.Departures...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ArrivalInMinutes < sorted[j].ArrivalInMinutes
+	})
+
+	ids := departureIDs{}
+	for _, departure := range sorted {
+		if departure.ArrivalInMinutes < 0 {
+			continue
+		}
+
+		start := base.Add(time.Duration(departure.ArrivalInMinutes) * time.Minute).UTC()
+		uid := ids.next(domains.Slug(departures.Station), departure, start) + "@kvb-api"
+
+		writeLine("BEGIN:VEVENT")
+		writeLine("UID:%s", uid)
+		writeLine("DTSTAMP:%s", stamp.Format(icsTimeFormat))
+		writeLine("LAST-MODIFIED:%s", stamp.Format(icsTimeFormat))
+		writeLine("DTSTART:%s", start.Format(icsTimeFormat))
+		writeLine("DTEND:%s", start.Add(icsEventDuration).Format(icsTimeFormat))
+		writeLine("SUMMARY:%s", escapeICSText(departure.Line+" → "+departure.Destination))
+		writeLine("LOCATION:%s", escapeICSText(departures.Station))
+		writeLine("TRANSP:TRANSPARENT")
+		writeLine("END:VEVENT")
+	}
+
+	writeLine("END:VCALENDAR")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeFolded writes a content line, folding it after 75 octets without splitting UTF-8 characters
+func writeFolded(buf *bytes.Buffer, line string) {
+	width := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > icsLineLength {
+			buf.WriteString("\r\n ")
+			// The leading space of the continuation counts towards its length
+			width = 1
+		}
+		buf.WriteRune(r)
+		width += size
+	}
+	buf.WriteString("\r\n")
+}
+
+func escapeICSText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(text)
+}
+
+func icsDuration(d time.Duration) string {
+	return fmt.Sprintf("PT%dM", max(1, int(d/time.Minute)))
+}
@@ -0,0 +1,168 @@
+package renderers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNotTabular is returned by encoders needing rows for values not implementing Tabular
+var ErrNotTabular = errors.New("value can't be rendered as table")
+
+// Encoder writes values in a single format
+type Encoder interface {
+	// Format is the name used in the format query parameter
+	Format() string
+	MediaType() string
+	Encode(buf *bytes.Buffer, v any) error
+}
+
+// Tabular is implemented by values that can be rendered as CSV or plain text table
+type Tabular interface {
+	Table() (header []string, rows [][]string)
+}
+
+// Default contains all encoders, the first one is used if the client has no preference
+var Default = New(JSON{}, CSV{}, XML{}, Text{})
+
+type Renderers struct {
+	encoders []Encoder
+}
+
+func New(encoders ...Encoder) *Renderers {
+	return &Renderers{encoders: encoders}
+}
+
+// Negotiate picks the encoder from the format query parameter or else the Accept header.
+// It returns false if the client requested a format explicitly that isn't available.
+func (renderers *Renderers) Negotiate(r *http.Request) (Encoder, bool) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		for _, encoder := range renderers.encoders {
+			if strings.EqualFold(encoder.Format(), format) {
+				return encoder, true
+			}
+		}
+		return nil, false
+	}
+
+	ranges := parseAccept(r.Header.Get("Accept"))
+	for _, mediaRange := range ranges {
+		// Browsers prefer HTML and list XML before */*, they should still get the default format
+		if mediaRange.mediaType == "text/html" {
+			return renderers.encoders[0], true
+		}
+	}
+
+	for _, mediaRange := range ranges {
+		for _, encoder := range renderers.encoders {
+			if matchesMediaRange(mediaRange, encoder.MediaType()) {
+				return encoder, true
+			}
+		}
+	}
+
+	return renderers.encoders[0], true
+}
+
+// Render encodes v with the negotiated encoder and writes it with the given status.
+// Responses depend on Accept, so caches are told to vary on it.
+func (renderers *Renderers) Render(w http.ResponseWriter, r *http.Request, status int, v any) error {
+	w.Header().Add("Vary", "Accept")
+
+	encoder, ok := renderers.Negotiate(r)
+	if !ok {
+		WriteError(w, http.StatusNotAcceptable, "unsupported format, supported are "+strings.Join(renderers.Formats(), ", "))
+		return nil
+	}
+
+	return Write(w, encoder, status, v)
+}
+
+// Write encodes v with encoder and writes it with the given status
+func Write(w http.ResponseWriter, encoder Encoder, status int, v any) error {
+	buf := &bytes.Buffer{}
+	if err := encoder.Encode(buf, v); err != nil {
+		WriteError(w, http.StatusInternalServerError, "error rendering response")
+		return err
+	}
+
+	w.Header().Set("Content-Type", encoder.MediaType())
+	w.WriteHeader(status)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// WriteError writes the JSON error response used by all endpoints, whatever format was negotiated
+func WriteError(w http.ResponseWriter, status int, message string) {
+	payload, _ := json.Marshal(errorResponse{Error: message})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(payload)
+}
+
+func (renderers *Renderers) Formats() []string {
+	formats := make([]string, 0, len(renderers.encoders))
+	for _, encoder := range renderers.encoders {
+		formats = append(formats, encoder.Format())
+	}
+	return formats
+}
+
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+// parseAccept returns the media ranges of an Accept header ordered by quality, ranges with quality 0 are dropped
+func parseAccept(header string) []mediaRange {
+	ranges := []mediaRange{}
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, found := params["q"]; found {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	return ranges
+}
+
+func matchesMediaRange(mediaRange mediaRange, contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	if mediaRange.mediaType == "*/*" {
+		return true
+	}
+	if strings.HasSuffix(mediaRange.mediaType, "/*") {
+		return strings.HasPrefix(mediaType, strings.TrimSuffix(mediaRange.mediaType, "*"))
+	}
+	return mediaRange.mediaType == mediaType
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+
+	"github.com/janritter/kvb-api/config"
+	"github.com/janritter/kvb-api/ports"
+)
+
+// APIClients are the clients allowed to call the API, each with the rate limiter of its API key. The HTTP and gRPC
+// APIs share them, so a client has one rate limit whichever API it calls.
+type APIClients struct {
+	clients map[string]*APIClient
+}
+
+type APIClient struct {
+	config.APIKey
+	limiter ports.RateLimiter
+}
+
+func NewAPIClients(keys []config.APIKey, newLimiter func(ratePerSecond float64, burst int) ports.RateLimiter) *APIClients {
+	clients := map[string]*APIClient{}
+	for _, key := range keys {
+		clients[key.Key] = &APIClient{
+			APIKey:  key,
+			limiter: newLimiter(key.RateLimit, key.Burst),
+		}
+	}
+
+	return &APIClients{clients: clients}
+}
+
+// Enabled reports whether API keys are configured, without keys all requests are let through
+func (clients *APIClients) Enabled() bool {
+	return len(clients.clients) > 0
+}
+
+// Client returns the client of an API key, false if the key is unknown
+func (clients *APIClients) Client(key string) (*APIClient, bool) {
+	client, found := clients.clients[key]
+	return client, found
+}
+
+// Reserve takes a token for a request of the client
+func (client *APIClient) Reserve(ctx context.Context) (ports.Reservation, error) {
+	return client.limiter.Reserve(ctx, client.Name, 0)
+}
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DeparturePublisherOptions configures the stations published by the DeparturePublisher and their topics
+type DeparturePublisherOptions struct {
+	Stations    []string
+	Interval    time.Duration
+	TopicPrefix string
+	// StatusTopic is announced to Home Assistant as availability topic of the sensors
+	StatusTopic string
+	// DiscoveryPrefix enables Home Assistant MQTT discovery messages if set, Home Assistant's default is "homeassistant"
+	DiscoveryPrefix string
+}
+
+// DeparturePublisher polls the departures of the configured stations and publishes them as retained messages to
+// <prefix>/<station>/departures and the next departure of every line to <prefix>/<station>/<line>/next
+type DeparturePublisher struct {
+	departureService ports.DepartureService
+	publisher        ports.MessagePublisher
+	options          DeparturePublisherOptions
+	logger           *slog.Logger
+
+	// announced holds the published discovery topics, lines are announced when they show up for the first time
+	announced map[string]bool
+	// lines holds the lines published per station topic, lines without departures are published as empty next departure
+	lines map[string]map[string]domains.Departure
+}
+
+// nextDeparture is the payload of the next departure topic of a line, ArrivalInMinutes is null without departures
+type nextDeparture struct {
+	Line             string        `json:"line"`
+	Destination      string        `json:"destination"`
+	ArrivalInMinutes *int          `json:"arrivalInMinutes"`
+	LineDetails      *domains.Line `json:"lineDetails,omitempty"`
+	Stale            bool          `json:"stale"`
+	FetchedAt        time.Time     `json:"fetchedAt"`
+}
+
+type homeAssistantSensor struct {
+	Name                   string              `json:"name"`
+	UniqueID               string              `json:"unique_id"`
+	StateTopic             string              `json:"state_topic"`
+	ValueTemplate          string              `json:"value_template"`
+	JSONAttributesTopic    string              `json:"json_attributes_topic"`
+	JSONAttributesTemplate string              `json:"json_attributes_template,omitempty"`
+	UnitOfMeasurement      string              `json:"unit_of_measurement"`
+	Icon                   string              `json:"icon"`
+	AvailabilityTopic      string              `json:"availability_topic,omitempty"`
+	Device                 homeAssistantDevice `json:"device"`
+}
+
+type homeAssistantDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer"`
+	Model        string   `json:"model"`
+}
+
+func NewDeparturePublisher(departureService ports.DepartureService, publisher ports.MessagePublisher, options DeparturePublisherOptions, logger *slog.Logger) *DeparturePublisher {
+	return &DeparturePublisher{
+		departureService: departureService,
+		publisher:        publisher,
+		options:          options,
+		logger:           logger,
+		announced:        map[string]bool{},
+		lines:            map[string]map[string]domains.Departure{},
+	}
+}
+
+// Run publishes the departures of all stations every interval until the context is cancelled
+func (publisher *DeparturePublisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(publisher.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		for _, station := range publisher.options.Stations {
+			publisher.publishStation(ctx, station)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (publisher *DeparturePublisher) publishStation(ctx context.Context, station string) {
+	ctx, span := otel.Tracer("kvb-api").Start(ctx, "PublishStation")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("station", station))
+
+	// A station must not hold up the next round
+	ctx, cancel := context.WithTimeout(ctx, publisher.options.Interval)
+	defer cancel()
+
+	departures, err := publisher.departureService.GetDeparturesForMatchingStation(ctx, station)
+	if err != nil {
+		// The retained messages keep the last known departures, they carry fetchedAt so consumers can tell
+		publisher.logger.WarnContext(ctx, "Error getting departures to publish", slog.String("station", station), slog.Any("error", err))
+		return
+	}
+
+	stationTopic := publisher.options.TopicPrefix + "/" + topicSegment(departures.Station)
+	if !publisher.publishJSON(ctx, stationTopic+"/departures", departures) {
+		return
+	}
+	publisher.announceStation(ctx, departures.Station, stationTopic)
+
+	// Lines seen before but without departures now are kept, so their next departure is cleared
+	lines := publisher.lines[stationTopic]
+	if lines == nil {
+		lines = map[string]domains.Departure{}
+		publisher.lines[stationTopic] = lines
+	}
+	next := map[string]domains.Departure{}
+	for _, departure := range departures.Departures {
+		if !departure.ArrivalKnown() {
+			continue
+		}
+		if current, found := next[departure.Line]; !found || departure.ArrivalInMinutes < current.ArrivalInMinutes {
+			next[departure.Line] = departure
+		}
+	}
+
+	for line := range next {
+		lines[line] = next[line]
+	}
+	for line, lastDeparture := range lines {
+		payload := nextDeparture{
+			Line:        line,
+			LineDetails: lastDeparture.LineDetails,
+			Stale:       departures.Stale,
+			FetchedAt:   departures.FetchedAt,
+		}
+		if departure, found := next[line]; found {
+			payload.Destination = departure.Destination
+			payload.ArrivalInMinutes = &departure.ArrivalInMinutes
+		}
+
+		lineTopic := stationTopic + "/" + topicSegment(line) + "/next"
+		if publisher.publishJSON(ctx, lineTopic, payload) {
+			publisher.announceLine(ctx, departures.Station, lastDeparture, lineTopic)
+		}
+	}
+}
+
+// announceStation publishes the Home Assistant discovery config of the station sensor, its state is the next departure
+func (publisher *DeparturePublisher) announceStation(ctx context.Context, station string, stationTopic string) {
+	publisher.announce(ctx, station, "departures", homeAssistantSensor{
+		Name:                   "Next departure",
+		StateTopic:             stationTopic + "/departures",
+		ValueTemplate:          "{{ value_json.departures[0].arrivalInMinutes if value_json.departures else None }}",
+		JSONAttributesTopic:    stationTopic + "/departures",
+		JSONAttributesTemplate: "{{ {'departures': value_json.departures, 'stale': value_json.stale, 'fetched_at': value_json.fetchedAt} | tojson }}",
+		Icon:                   "mdi:bus-clock",
+	})
+}
+
+// announceLine publishes the Home Assistant discovery config of the sensor of a line at a station
+func (publisher *DeparturePublisher) announceLine(ctx context.Context, station string, departure domains.Departure, lineTopic string) {
+	icon := "mdi:bus"
+	if departure.LineDetails != nil && departure.LineDetails.Mode == domains.LineModeLightRail {
+		icon = "mdi:tram"
+	}
+
+	publisher.announce(ctx, station, "line_"+topicSegment(departure.Line), homeAssistantSensor{
+		Name:                "Line " + departure.Line,
+		StateTopic:          lineTopic,
+		ValueTemplate:       "{{ value_json.arrivalInMinutes }}",
+		JSONAttributesTopic: lineTopic,
+		Icon:                icon,
+	})
+}
+
+func (publisher *DeparturePublisher) announce(ctx context.Context, station string, objectID string, sensor homeAssistantSensor) {
+	if publisher.options.DiscoveryPrefix == "" {
+		return
+	}
+
+	nodeID := "kvb_" + topicSegment(station)
+	topic := publisher.options.DiscoveryPrefix + "/sensor/" + nodeID + "/" + objectID + "/config"
+	if publisher.announced[topic] {
+		return
+	}
+
+	sensor.UniqueID = nodeID + "_" + objectID
+	sensor.UnitOfMeasurement = "min"
+	sensor.AvailabilityTopic = publisher.options.StatusTopic
+	sensor.Device = homeAssistantDevice{
+		Identifiers:  []string{nodeID},
+		Name:         "KVB " + station,
+		Manufacturer: "KVB",
+		Model:        "Departures",
+	}
+
+	if publisher.publishJSON(ctx, topic, sensor) {
+		publisher.announced[topic] = true
+	}
+}
+
+func (publisher *DeparturePublisher) publishJSON(ctx context.Context, topic string, v any) bool {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		publisher.logger.ErrorContext(ctx, "Error encoding MQTT message", slog.String("topic", topic), slog.Any("error", err))
+		return false
+	}
+
+	if err := publisher.publisher.Publish(ctx, topic, payload); err != nil {
+		publisher.logger.WarnContext(ctx, "Error publishing MQTT message", slog.String("topic", topic), slog.Any("error", err))
+		return false
+	}
+	return true
+}
+
+// topicSegment turns a station or line name into a lowercase ASCII topic level, which Home Assistant requires for IDs
+func topicSegment(name string) string {
+	return domains.Slug(strings.NewReplacer("ä", "ae", "ö", "oe", "ü", "ue", "Ä", "Ae", "Ö", "Oe", "Ü", "Ue", "ß", "ss").Replace(name))
+}
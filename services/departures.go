@@ -2,7 +2,7 @@ package services
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
 	"github.com/janritter/kvb-api/domains"
 	"github.com/janritter/kvb-api/ports"
@@ -12,14 +12,22 @@ import (
 )
 
 type service struct {
-	stationMapperAdapter ports.StationMapperAdapter
-	kvbAdapter           ports.KVBAdapter
+	stationMapperAdapter  ports.StationMapperAdapter
+	stationDetailsAdapter ports.StationDetailsAdapter
+	lineRegistryAdapter   ports.LineRegistryAdapter
+	providers             *providerRegistry
+	logger                *slog.Logger
 }
 
-func New(stationMapperAdapter ports.StationMapperAdapter, kvbAdapter ports.KVBAdapter) *service {
+// New creates the service, stationDetailsAdapter is optional and stations are returned without details if it is nil.
+// All departures and searches are routed to the providers, which need to include KVB.
+func New(stationMapperAdapter ports.StationMapperAdapter, stationDetailsAdapter ports.StationDetailsAdapter, lineRegistryAdapter ports.LineRegistryAdapter, providers []ports.DepartureProvider, logger *slog.Logger) *service {
 	return &service{
-		stationMapperAdapter: stationMapperAdapter,
-		kvbAdapter:           kvbAdapter,
+		stationMapperAdapter:  stationMapperAdapter,
+		stationDetailsAdapter: stationDetailsAdapter,
+		lineRegistryAdapter:   lineRegistryAdapter,
+		providers:             newProviderRegistry(providers, logger),
+		logger:                logger,
 	}
 }
 
@@ -30,17 +38,45 @@ func (srv *service) GetDeparturesForMatchingStation(ctx context.Context, station
 
 	span.SetAttributes(attribute.String("station", station))
 
-	stationID, err := srv.stationMapperAdapter.GetStationIDForName(ctx, station)
+	if ref, ok := domains.ParseStationRef(station); ok && srv.providers.has(ref.Provider) {
+		return srv.GetDeparturesForStation(ctx, ref)
+	}
+
+	foundStation, err := srv.stationMapperAdapter.GetStationForName(ctx, station)
 	if err != nil {
-		log.Printf("Error getting station ID for name: %s", err)
+		srv.logger.WarnContext(ctx, "Error getting station for name", slog.String("station", station), slog.Any("error", err))
 		return domains.Departures{}, err
 	}
 
-	departures, err := srv.kvbAdapter.GetDeparturesForStationID(ctx, stationID)
+	return srv.GetDeparturesForStation(ctx, domains.KVBStationRef(foundStation.ID))
+}
+
+func (srv *service) GetDeparturesForStationID(ctx context.Context, stationID int) (domains.Departures, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetDeparturesForStationID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stationID", stationID))
+
+	return srv.GetDeparturesForStation(ctx, domains.KVBStationRef(stationID))
+}
+
+func (srv *service) GetDeparturesForStation(ctx context.Context, ref domains.StationRef) (domains.Departures, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetDeparturesForStation")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("station", ref.String()))
+
+	departures, err := srv.providers.getDepartures(ctx, ref)
 	if err != nil {
-		log.Printf("Error getting departures for station ID: %s", err)
+		srv.logger.ErrorContext(ctx, "Error getting departures for station", slog.String("station", ref.String()), slog.Any("error", err))
 		return domains.Departures{}, err
 	}
 
-	return departures, nil
+	// The line registry only knows KVB lines
+	if ref.Provider != domains.DefaultProvider {
+		return departures, nil
+	}
+	return srv.withLineDetails(ctx, departures), nil
 }
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type historyService struct {
+	repository           ports.DepartureHistoryRepository
+	stationMapperAdapter ports.StationMapperAdapter
+	// trackingGap is the longest time between snapshots across which the stats follow departures
+	trackingGap time.Duration
+	logger      *slog.Logger
+}
+
+// NewHistoryService returns the history and stats service, downsampleInterval is the interval the history is
+// downsampled to, so the stats can follow departures through downsampled snapshots
+func NewHistoryService(repository ports.DepartureHistoryRepository, stationMapperAdapter ports.StationMapperAdapter, downsampleInterval time.Duration, logger *slog.Logger) *historyService {
+	return &historyService{
+		repository:           repository,
+		stationMapperAdapter: stationMapperAdapter,
+		// Downsampling keeps one snapshot per interval, so the kept snapshots are up to two intervals apart
+		trackingGap: max(minTrackingGap, 2*downsampleInterval),
+		logger:      logger,
+	}
+}
+
+func (srv *historyService) GetSnapshots(ctx context.Context, stationID int, from time.Time, to time.Time) ([]domains.DepartureSnapshot, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetSnapshots")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stationID", stationID), attribute.String("from", from.String()), attribute.String("to", to.String()))
+
+	snapshots, err := srv.repository.ListSnapshots(ctx, stationID, from, to)
+	if err != nil {
+		srv.logger.ErrorContext(ctx, "Error listing departure snapshots", slog.Int("stationID", stationID), slog.Any("error", err))
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// HistoryRetentionOptions configures how long snapshots are kept, zero values disable the respective step
+type HistoryRetentionOptions struct {
+	// Retention is the age after which snapshots are deleted
+	Retention time.Duration
+	// DownsampleAfter is the age after which only one snapshot per station and DownsampleInterval is kept
+	DownsampleAfter    time.Duration
+	DownsampleInterval time.Duration
+	// Interval is the time between runs of the retention
+	Interval time.Duration
+}
+
+// HistoryRetention applies the retention policies to the history and compacts it when snapshots were removed
+type HistoryRetention struct {
+	repository ports.DepartureHistoryRepository
+	options    HistoryRetentionOptions
+	logger     *slog.Logger
+}
+
+func NewHistoryRetention(repository ports.DepartureHistoryRepository, options HistoryRetentionOptions, logger *slog.Logger) *HistoryRetention {
+	return &HistoryRetention{
+		repository: repository,
+		options:    options,
+		logger:     logger,
+	}
+}
+
+// Run applies the retention every interval until the context is cancelled
+func (retention *HistoryRetention) Run(ctx context.Context) {
+	ticker := time.NewTicker(retention.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		retention.apply(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (retention *HistoryRetention) apply(ctx context.Context, now time.Time) {
+	removed := 0
+
+	if retention.options.Retention > 0 {
+		deleted, err := retention.repository.DeleteSnapshotsBefore(ctx, now.Add(-retention.options.Retention))
+		if err != nil {
+			retention.logger.ErrorContext(ctx, "Error deleting expired departure snapshots", slog.Any("error", err))
+			return
+		}
+		removed += deleted
+	}
+
+	if retention.options.DownsampleAfter > 0 && retention.options.DownsampleInterval > 0 {
+		downsampled, err := retention.repository.Downsample(ctx, now.Add(-retention.options.DownsampleAfter), retention.options.DownsampleInterval)
+		if err != nil {
+			retention.logger.ErrorContext(ctx, "Error downsampling departure snapshots", slog.Any("error", err))
+			return
+		}
+		removed += downsampled
+	}
+
+	if removed == 0 {
+		return
+	}
+
+	if err := retention.repository.Compact(ctx); err != nil {
+		retention.logger.ErrorContext(ctx, "Error compacting departure history", slog.Any("error", err))
+		return
+	}
+	retention.logger.InfoContext(ctx, "Applied departure history retention", slog.Int("removed", removed))
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/janritter/kvb-api/domains"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (srv *service) GetLines(ctx context.Context) ([]domains.Line, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetLines")
+	defer span.End()
+
+	lines, err := srv.lineRegistryAdapter.GetLines(ctx)
+	if err != nil {
+		srv.logger.ErrorContext(ctx, "Error getting lines", slog.Any("error", err))
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+func (srv *service) GetLine(ctx context.Context, name string) (domains.Line, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetLine")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("line", name))
+
+	return srv.lineRegistryAdapter.GetLine(ctx, name)
+}
+
+// withLineDetails returns a copy of the departures with the details of their lines,
+// the departures may be shared with the cache and are not modified
+func (srv *service) withLineDetails(ctx context.Context, departures domains.Departures) domains.Departures {
+	enriched := make([]domains.Departure, len(departures.Departures))
+	for i, departure := range departures.Departures {
+		if line, err := srv.lineRegistryAdapter.GetLine(ctx, departure.Line); err == nil {
+			departure.LineDetails = &line
+		}
+		enriched[i] = departure
+	}
+
+	departures.Departures = enriched
+	return departures
+}
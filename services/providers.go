@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+)
+
+// providerRegistry routes station references to the enabled departure providers
+type providerRegistry struct {
+	providers map[string]ports.DepartureProvider
+	// names keeps the order the providers were enabled in, search results are merged in this order
+	names  []string
+	logger *slog.Logger
+}
+
+func newProviderRegistry(providers []ports.DepartureProvider, logger *slog.Logger) *providerRegistry {
+	registry := &providerRegistry{
+		providers: map[string]ports.DepartureProvider{},
+		logger:    logger,
+	}
+	for _, provider := range providers {
+		registry.providers[provider.Name()] = provider
+		registry.names = append(registry.names, provider.Name())
+	}
+	return registry
+}
+
+func (registry *providerRegistry) has(name string) bool {
+	_, found := registry.providers[name]
+	return found
+}
+
+func (registry *providerRegistry) getDepartures(ctx context.Context, ref domains.StationRef) (domains.Departures, error) {
+	provider, found := registry.providers[ref.Provider]
+	if !found {
+		return domains.Departures{}, fmt.Errorf("%w: %s", domains.ErrProviderNotFound, ref.Provider)
+	}
+	return provider.GetDepartures(ctx, ref.ID)
+}
+
+// searchStations searches all providers concurrently and interleaves their results by rank, so the best matches of
+// every provider come first. Failing providers are left out, an error is only returned if all of them failed.
+func (registry *providerRegistry) searchStations(ctx context.Context, query string, limit int) ([]domains.Station, error) {
+	results := make([][]domains.Station, len(registry.names))
+	errs := make([]error, len(registry.names))
+	var wg sync.WaitGroup
+	for i, name := range registry.names {
+		wg.Add(1)
+		go func(i int, provider ports.DepartureProvider) {
+			defer wg.Done()
+			results[i], errs[i] = provider.SearchStations(ctx, query, limit)
+		}(i, registry.providers[name])
+	}
+	wg.Wait()
+
+	failed := 0
+	for i, err := range errs {
+		if err != nil {
+			failed++
+			registry.logger.WarnContext(ctx, "Error searching stations of provider", slog.String("provider", registry.names[i]), slog.Any("error", err))
+		}
+	}
+	if failed > 0 && failed == len(errs) {
+		return nil, errs[0]
+	}
+
+	stations := []domains.Station{}
+	for rank := 0; ; rank++ {
+		added := false
+		for _, result := range results {
+			if rank >= len(result) {
+				continue
+			}
+			if limit > 0 && len(stations) >= limit {
+				return stations, nil
+			}
+			stations = append(stations, result[rank])
+			added = true
+		}
+		if !added {
+			return stations, nil
+		}
+	}
+}
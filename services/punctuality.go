@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// trackingTolerance is how far the estimated departure time may move between two snapshots to still be the same departure.
+	// Departures of a line and destination are usually more than twice as far apart.
+	trackingTolerance = 3 * time.Minute
+	// minTrackingGap is the longest time between snapshots across which departures are followed unless the history is
+	// downsampled to longer intervals, sparsely polled history can't tell whether a departure left or vanished
+	minTrackingGap = 3 * time.Minute
+	// disappearanceGrace is how long past its estimate a departure may still be shown before vanishing counts as departed
+	disappearanceGrace = time.Minute
+)
+
+// trackedDeparture is a departure followed across successive snapshots
+type trackedDeparture struct {
+	line        string
+	destination string
+	// first and last are the estimated departure times at the first and last sighting
+	first     time.Time
+	last      time.Time
+	sightings int
+	// disappeared is set when the departure vanished from the countdown before it was due
+	disappeared bool
+}
+
+func (srv *historyService) GetStationStats(ctx context.Context, stationID int, from time.Time, to time.Time) (domains.PunctualityStats, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetStationStats")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stationID", stationID))
+
+	station, err := srv.stationMapperAdapter.GetStationForID(ctx, stationID)
+	if err != nil {
+		return domains.PunctualityStats{}, err
+	}
+
+	snapshots, err := srv.repository.ListSnapshots(ctx, stationID, from, to)
+	if err != nil {
+		srv.logger.ErrorContext(ctx, "Error listing departure snapshots", slog.Int("stationID", stationID), slog.Any("error", err))
+		return domains.PunctualityStats{}, err
+	}
+
+	stats := aggregatePunctuality(trackDepartures(snapshots, srv.trackingGap))
+	stats.StationID = &station.ID
+	stats.Station = station.Name
+	stats.From = from
+	stats.To = to
+	return stats, nil
+}
+
+func (srv *historyService) GetLineStats(ctx context.Context, line string, from time.Time, to time.Time) (domains.PunctualityStats, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetLineStats")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("line", line))
+
+	stationIDs, err := srv.repository.ListStationIDs(ctx)
+	if err != nil {
+		srv.logger.ErrorContext(ctx, "Error listing recorded stations", slog.Any("error", err))
+		return domains.PunctualityStats{}, err
+	}
+
+	// Every station is tracked on its own, the line is followed at all stations it was recorded at
+	var tracked []trackedDeparture
+	for _, stationID := range stationIDs {
+		// All snapshots of every station are read, stop as soon as nobody waits for the result anymore
+		if err := ctx.Err(); err != nil {
+			return domains.PunctualityStats{}, err
+		}
+
+		snapshots, err := srv.repository.ListSnapshots(ctx, stationID, from, to)
+		if err != nil {
+			srv.logger.ErrorContext(ctx, "Error listing departure snapshots", slog.Int("stationID", stationID), slog.Any("error", err))
+			return domains.PunctualityStats{}, err
+		}
+
+		for _, departure := range trackDepartures(snapshots, srv.trackingGap) {
+			if strings.EqualFold(departure.line, line) {
+				tracked = append(tracked, departure)
+			}
+		}
+	}
+
+	stats := aggregatePunctuality(tracked)
+	stats.Line = line
+	stats.From = from
+	stats.To = to
+	return stats, nil
+}
+
+// trackDepartures follows the departures through the snapshots, which must be ordered by fetch time, up to gaps of maxGap.
+// A sighting continues the open departure of the same line and destination with the closest estimated departure time.
+func trackDepartures(snapshots []domains.DepartureSnapshot, maxGap time.Duration) []trackedDeparture {
+	var finished, open []trackedDeparture
+	var previous time.Time
+
+	for _, snapshot := range snapshots {
+		if !previous.IsZero() && snapshot.FetchedAt.Sub(previous) > maxGap {
+			finished = append(finished, open...)
+			open = nil
+		}
+		previous = snapshot.FetchedAt
+
+		matched := make([]bool, len(open))
+		var started []trackedDeparture
+		// horizon is the latest estimate shown, departures beyond it may just have dropped off the end of the list
+		var horizon time.Time
+
+		for _, departure := range snapshot.Departures {
+			if departure.ArrivalInMinutes < 0 {
+				continue
+			}
+			estimate := snapshot.FetchedAt.Add(time.Duration(departure.ArrivalInMinutes) * time.Minute)
+			if estimate.After(horizon) {
+				horizon = estimate
+			}
+
+			closest := -1
+			for i, candidate := range open {
+				if matched[i] || candidate.line != departure.Line || candidate.destination != departure.Destination {
+					continue
+				}
+				distance := absDuration(estimate.Sub(candidate.last))
+				if distance <= trackingTolerance && (closest < 0 || distance < absDuration(estimate.Sub(open[closest].last))) {
+					closest = i
+				}
+			}
+
+			if closest < 0 {
+				started = append(started, trackedDeparture{
+					line:        departure.Line,
+					destination: departure.Destination,
+					first:       estimate,
+					last:        estimate,
+					sightings:   1,
+				})
+				continue
+			}
+			matched[closest] = true
+			open[closest].last = estimate
+			open[closest].sightings++
+		}
+
+		still := started
+		for i, departure := range open {
+			if matched[i] {
+				still = append(still, departure)
+				continue
+			}
+			departure.disappeared = departure.last.After(snapshot.FetchedAt.Add(disappearanceGrace)) && departure.last.Before(horizon)
+			finished = append(finished, departure)
+		}
+		open = still
+	}
+
+	return append(finished, open...)
+}
+
+// aggregatePunctuality computes the stats of the departures, departures seen only once carry no information and are left out
+func aggregatePunctuality(tracked []trackedDeparture) domains.PunctualityStats {
+	var all []trackedDeparture
+	byHour := make([][]trackedDeparture, 24)
+	byWeekday := make([][]trackedDeparture, 7)
+
+	for _, departure := range tracked {
+		if departure.sightings < 2 {
+			continue
+		}
+		local := departure.first.In(domains.Location)
+		all = append(all, departure)
+		byHour[local.Hour()] = append(byHour[local.Hour()], departure)
+		// Weeks start on Monday
+		weekday := (int(local.Weekday()) + 6) % 7
+		byWeekday[weekday] = append(byWeekday[weekday], departure)
+	}
+
+	stats := domains.PunctualityStats{
+		DepartureStats: departureStats(all),
+		ByHour:         make([]domains.HourStats, 0, len(byHour)),
+		ByWeekday:      make([]domains.WeekdayStats, 0, len(byWeekday)),
+	}
+	for hour, departures := range byHour {
+		stats.ByHour = append(stats.ByHour, domains.HourStats{Hour: hour, DepartureStats: departureStats(departures)})
+	}
+	for weekday, departures := range byWeekday {
+		stats.ByWeekday = append(stats.ByWeekday, domains.WeekdayStats{
+			Weekday:        time.Weekday((weekday + 1) % 7).String(),
+			DepartureStats: departureStats(departures),
+		})
+	}
+	return stats
+}
+
+func departureStats(departures []trackedDeparture) domains.DepartureStats {
+	stats := domains.DepartureStats{Departures: len(departures)}
+	if len(departures) == 0 {
+		return stats
+	}
+
+	drifts := make([]int, 0, len(departures))
+	for _, departure := range departures {
+		if departure.disappeared {
+			stats.Disappeared++
+		}
+		drifts = append(drifts, int(math.Round(departure.last.Sub(departure.first).Minutes())))
+	}
+	sort.Ints(drifts)
+
+	stats.DisappearanceRate = float64(stats.Disappeared) / float64(len(departures))
+	stats.DelayDrift = domains.DriftPercentiles{
+		P50: percentile(drifts, 0.5),
+		P90: percentile(drifts, 0.9),
+		P95: percentile(drifts, 0.95),
+		Max: drifts[len(drifts)-1],
+	}
+	return stats
+}
+
+// percentile returns the nearest rank percentile of the sorted values
+func percentile(sorted []int, p float64) int {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
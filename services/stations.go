@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"github.com/janritter/kvb-api/domains"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (srv *service) GetStations(ctx context.Context) ([]domains.Station, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetStations")
+	defer span.End()
+
+	stations, err := srv.stationMapperAdapter.GetStations(ctx)
+	if err != nil {
+		srv.logger.ErrorContext(ctx, "Error getting stations", slog.Any("error", err))
+		return nil, err
+	}
+
+	for i := range stations {
+		stations[i] = srv.withDetails(ctx, stations[i])
+	}
+
+	return stations, nil
+}
+
+func (srv *service) GetStationForID(ctx context.Context, stationID int) (domains.Station, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetStationForID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stationID", stationID))
+
+	station, err := srv.stationMapperAdapter.GetStationForID(ctx, stationID)
+	if err != nil {
+		srv.logger.WarnContext(ctx, "Error getting station for ID", slog.Int("stationID", stationID), slog.Any("error", err))
+		return domains.Station{}, err
+	}
+
+	return srv.withDetails(ctx, station), nil
+}
+
+func (srv *service) SearchStations(ctx context.Context, query string, limit int) ([]domains.Station, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "SearchStations")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query", query))
+
+	stations, err := srv.providers.searchStations(ctx, query, limit)
+	if err != nil {
+		srv.logger.ErrorContext(ctx, "Error searching stations", slog.String("query", query), slog.Any("error", err))
+		return nil, err
+	}
+
+	for i := range stations {
+		stations[i] = srv.withDetails(ctx, stations[i])
+	}
+
+	return stations, nil
+}
+
+func (srv *service) GetNearbyStations(ctx context.Context, stationID int, radius float64, limit int) ([]domains.NearbyStation, error) {
+	var span trace.Span
+	ctx, span = otel.Tracer("kvb-api").Start(ctx, "GetNearbyStations")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stationID", stationID), attribute.Float64("radius", radius))
+
+	station, err := srv.GetStationForID(ctx, stationID)
+	if err != nil {
+		return nil, err
+	}
+	nearby := []domains.NearbyStation{}
+	if station.StationDetails == nil {
+		return nearby, nil
+	}
+
+	stations, err := srv.GetStations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range stations {
+		if other.ID == station.ID || other.StationDetails == nil {
+			continue
+		}
+		if distance := station.DistanceTo(other); distance <= radius {
+			nearby = append(nearby, domains.NearbyStation{Station: other, Distance: distance})
+		}
+	}
+
+	sort.Slice(nearby, func(i, j int) bool {
+		return nearby[i].Distance < nearby[j].Distance
+	})
+	if limit > 0 && len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+	return nearby, nil
+}
+
+// withDetails adds the details of the static GTFS feed to KVB stations
+func (srv *service) withDetails(ctx context.Context, station domains.Station) domains.Station {
+	if srv.stationDetailsAdapter == nil || station.ID == 0 {
+		return station
+	}
+
+	if details, found := srv.stationDetailsAdapter.GetStationDetails(ctx, station.ID); found {
+		station.StationDetails = &details
+	}
+	return station
+}
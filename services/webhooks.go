@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+)
+
+type webhookService struct {
+	repository ports.WebhookRuleRepository
+	sender     ports.WebhookSender
+	logger     *slog.Logger
+}
+
+func NewWebhookService(repository ports.WebhookRuleRepository, sender ports.WebhookSender, logger *slog.Logger) *webhookService {
+	return &webhookService{
+		repository: repository,
+		sender:     sender,
+		logger:     logger,
+	}
+}
+
+// ListWebhookRules returns the rules of the owner
+func (srv *webhookService) ListWebhookRules(ctx context.Context, owner string) ([]domains.WebhookRule, error) {
+	rules, err := srv.repository.ListWebhookRules(ctx)
+	if err != nil {
+		srv.logger.ErrorContext(ctx, "Error listing webhook rules", slog.Any("error", err))
+		return nil, err
+	}
+
+	owned := []domains.WebhookRule{}
+	for _, rule := range rules {
+		if rule.Owner == owner {
+			owned = append(owned, rule)
+		}
+	}
+	return owned, nil
+}
+
+// GetWebhookRule returns the rule, rules of other owners are reported as not found
+func (srv *webhookService) GetWebhookRule(ctx context.Context, owner string, id string) (domains.WebhookRule, error) {
+	rule, err := srv.repository.GetWebhookRule(ctx, id)
+	if err != nil {
+		return domains.WebhookRule{}, err
+	}
+	if rule.Owner != owner {
+		return domains.WebhookRule{}, domains.ErrWebhookRuleNotFound
+	}
+	return rule, nil
+}
+
+// CreateWebhookRule stores a new rule, a signing secret is generated unless the rule brings its own
+func (srv *webhookService) CreateWebhookRule(ctx context.Context, rule domains.WebhookRule) (domains.WebhookRule, error) {
+	if err := srv.validate(ctx, rule); err != nil {
+		return domains.WebhookRule{}, err
+	}
+
+	rule.ID = randomHex(16)
+	if rule.Secret == "" {
+		rule.Secret = randomHex(32)
+	}
+	rule.CreatedAt = time.Now().UTC()
+	rule.UpdatedAt = rule.CreatedAt
+
+	if err := srv.repository.SaveWebhookRule(ctx, rule); err != nil {
+		srv.logger.ErrorContext(ctx, "Error saving webhook rule", slog.Any("error", err))
+		return domains.WebhookRule{}, err
+	}
+	return rule, nil
+}
+
+// UpdateWebhookRule replaces the rule, the secret is kept unless a new one is given
+func (srv *webhookService) UpdateWebhookRule(ctx context.Context, rule domains.WebhookRule) (domains.WebhookRule, error) {
+	existing, err := srv.GetWebhookRule(ctx, rule.Owner, rule.ID)
+	if err != nil {
+		return domains.WebhookRule{}, err
+	}
+	if err := srv.validate(ctx, rule); err != nil {
+		return domains.WebhookRule{}, err
+	}
+
+	if rule.Secret == "" {
+		rule.Secret = existing.Secret
+	}
+	rule.CreatedAt = existing.CreatedAt
+	rule.UpdatedAt = time.Now().UTC()
+
+	if err := srv.repository.SaveWebhookRule(ctx, rule); err != nil {
+		srv.logger.ErrorContext(ctx, "Error saving webhook rule", slog.Any("error", err))
+		return domains.WebhookRule{}, err
+	}
+	return rule, nil
+}
+
+func (srv *webhookService) DeleteWebhookRule(ctx context.Context, owner string, id string) error {
+	if _, err := srv.GetWebhookRule(ctx, owner, id); err != nil {
+		return err
+	}
+	return srv.repository.DeleteWebhookRule(ctx, id)
+}
+
+// validate checks the rule and whether webhooks can be sent to its URL
+func (srv *webhookService) validate(ctx context.Context, rule domains.WebhookRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	return srv.sender.CheckWebhookURL(ctx, rule.URL)
+}
+
+func randomHex(bytes int) string {
+	buf := make([]byte, bytes)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/janritter/kvb-api/domains"
+	"github.com/janritter/kvb-api/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// webhookDepartureTolerance is the drift of the estimated departure time up to which two sightings are the same departure.
+	// Departures are only known by their countdown, delays shift the estimate a bit between polls.
+	webhookDepartureTolerance = 2 * time.Minute
+	// webhookDeliveredRetention is how long delivered departures are remembered after their departure
+	webhookDeliveredRetention = 10 * time.Minute
+)
+
+// WebhookScheduler polls the departures of the stations of all webhook rules and alerts each departure once
+// when its countdown reaches the minutes of the rule
+type WebhookScheduler struct {
+	departureService   ports.DepartureService
+	repository         ports.WebhookRuleRepository
+	deliveryRepository ports.WebhookDeliveryRepository
+	sender             ports.WebhookSender
+	interval           time.Duration
+	logger             *slog.Logger
+
+	mu sync.Mutex
+	// delivered holds the alerted departures per rule ID
+	delivered map[string][]domains.WebhookDelivery
+	// changed is set when delivered differs from the stored deliveries
+	changed bool
+}
+
+func NewWebhookScheduler(departureService ports.DepartureService, repository ports.WebhookRuleRepository, deliveryRepository ports.WebhookDeliveryRepository, sender ports.WebhookSender, interval time.Duration, logger *slog.Logger) *WebhookScheduler {
+	return &WebhookScheduler{
+		departureService:   departureService,
+		repository:         repository,
+		deliveryRepository: deliveryRepository,
+		sender:             sender,
+		interval:           interval,
+		logger:             logger,
+		delivered:          map[string][]domains.WebhookDelivery{},
+	}
+}
+
+// Run evaluates the rules every interval until the context is cancelled, starting with the deliveries stored before
+func (scheduler *WebhookScheduler) Run(ctx context.Context) {
+	delivered, err := scheduler.deliveryRepository.ListWebhookDeliveries(ctx)
+	if err != nil {
+		scheduler.logger.ErrorContext(ctx, "Error loading webhook deliveries, departures may be alerted again", slog.Any("error", err))
+	} else {
+		scheduler.mu.Lock()
+		scheduler.delivered = delivered
+		scheduler.mu.Unlock()
+	}
+
+	ticker := time.NewTicker(scheduler.interval)
+	defer ticker.Stop()
+
+	for {
+		scheduler.evaluate(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (scheduler *WebhookScheduler) evaluate(ctx context.Context, now time.Time) {
+	ctx, span := otel.Tracer("kvb-api").Start(ctx, "EvaluateWebhookRules")
+	defer span.End()
+
+	rules, err := scheduler.repository.ListWebhookRules(ctx)
+	if err != nil {
+		scheduler.logger.ErrorContext(ctx, "Error listing webhook rules", slog.Any("error", err))
+		return
+	}
+	span.SetAttributes(attribute.Int("rules", len(rules)))
+
+	// Departures are fetched once per station, no matter how many rules watch it
+	rulesByStation := map[string][]domains.WebhookRule{}
+	for _, rule := range rules {
+		key := strings.ToLower(rule.Station)
+		rulesByStation[key] = append(rulesByStation[key], rule)
+	}
+
+	for _, stationRules := range rulesByStation {
+		departures, err := scheduler.departureService.GetDeparturesForMatchingStation(ctx, stationRules[0].Station)
+		if err != nil {
+			scheduler.logger.WarnContext(ctx, "Error getting departures for webhook rules", slog.String("station", stationRules[0].Station), slog.Any("error", err))
+			continue
+		}
+
+		for _, rule := range stationRules {
+			scheduler.evaluateRule(ctx, rule, departures)
+		}
+	}
+
+	scheduler.forget(now, rules)
+	scheduler.saveDelivered(ctx)
+}
+
+func (scheduler *WebhookScheduler) evaluateRule(ctx context.Context, rule domains.WebhookRule, departures domains.Departures) {
+	filter := rule.Filter()
+	base := departures.FetchedAt.Truncate(time.Minute)
+
+	for _, departure := range departures.Departures {
+		if departure.ArrivalInMinutes < 0 || departure.ArrivalInMinutes > rule.MinutesBefore || !filter.Matches(departure) {
+			continue
+		}
+
+		departureTime := base.Add(time.Duration(departure.ArrivalInMinutes) * time.Minute)
+		if !scheduler.markDelivered(rule.ID, departure, departureTime) {
+			continue
+		}
+
+		payload, err := json.Marshal(domains.WebhookAlert{
+			RuleID:           rule.ID,
+			Station:          departures.Station,
+			Line:             departure.Line,
+			Destination:      departure.Destination,
+			ArrivalInMinutes: departure.ArrivalInMinutes,
+			DepartureTime:    departureTime,
+			LineDetails:      departure.LineDetails,
+			Stale:            departures.Stale,
+		})
+		if err != nil {
+			scheduler.logger.ErrorContext(ctx, "Error encoding webhook alert", slog.Any("error", err))
+			continue
+		}
+
+		deliveryID := deliveryID(rule.ID, departure, departureTime)
+		// Retries must neither block the next evaluation nor end with the evaluation
+		go func(rule domains.WebhookRule) {
+			ctx := context.WithoutCancel(ctx)
+			if err := scheduler.sender.SendWebhook(ctx, rule.URL, rule.Secret, deliveryID, payload); err != nil {
+				scheduler.logger.WarnContext(ctx, "Error delivering webhook", slog.String("rule", rule.ID), slog.String("delivery", deliveryID), slog.Any("error", err))
+				return
+			}
+			scheduler.logger.InfoContext(ctx, "Delivered webhook", slog.String("rule", rule.ID), slog.String("delivery", deliveryID))
+		}(rule)
+	}
+}
+
+// markDelivered records the departure for the rule, it returns false if the departure was already alerted
+func (scheduler *WebhookScheduler) markDelivered(ruleID string, departure domains.Departure, departureTime time.Time) bool {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	for _, delivered := range scheduler.delivered[ruleID] {
+		drift := delivered.DepartureTime.Sub(departureTime)
+		if delivered.Line == departure.Line && delivered.Destination == departure.Destination &&
+			drift <= webhookDepartureTolerance && drift >= -webhookDepartureTolerance {
+			return false
+		}
+	}
+
+	scheduler.delivered[ruleID] = append(scheduler.delivered[ruleID], domains.WebhookDelivery{
+		Line:          departure.Line,
+		Destination:   departure.Destination,
+		DepartureTime: departureTime,
+	})
+	scheduler.changed = true
+	return true
+}
+
+// forget drops delivered departures which left long ago and those of deleted rules
+func (scheduler *WebhookScheduler) forget(now time.Time, rules []domains.WebhookRule) {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	active := map[string]bool{}
+	for _, rule := range rules {
+		active[rule.ID] = true
+	}
+
+	for ruleID, deliveries := range scheduler.delivered {
+		if !active[ruleID] {
+			delete(scheduler.delivered, ruleID)
+			scheduler.changed = true
+			continue
+		}
+
+		kept := deliveries[:0]
+		for _, delivered := range deliveries {
+			if now.Sub(delivered.DepartureTime) < webhookDeliveredRetention {
+				kept = append(kept, delivered)
+			}
+		}
+		if len(kept) == 0 {
+			delete(scheduler.delivered, ruleID)
+		} else {
+			scheduler.delivered[ruleID] = kept
+		}
+		scheduler.changed = scheduler.changed || len(kept) != len(deliveries)
+	}
+}
+
+// saveDelivered stores the delivered departures if they changed, so a restart doesn't alert them again
+func (scheduler *WebhookScheduler) saveDelivered(ctx context.Context) {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	if !scheduler.changed {
+		return
+	}
+	if err := scheduler.deliveryRepository.SaveWebhookDeliveries(ctx, scheduler.delivered); err != nil {
+		scheduler.logger.ErrorContext(ctx, "Error saving webhook deliveries", slog.Any("error", err))
+		return
+	}
+	scheduler.changed = false
+}
+
+// deliveryID identifies a delivery for receivers de-duplicating retries
+func deliveryID(ruleID string, departure domains.Departure, departureTime time.Time) string {
+	hash := sha256.Sum256([]byte(ruleID + "|" + departure.Line + "|" + departure.Destination + "|" + departureTime.UTC().Format(time.RFC3339)))
+	return hex.EncodeToString(hash[:12])
+}